@@ -0,0 +1,294 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package extensiondatasource
+
+import (
+	"context"
+	"fmt"
+	"path"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/elastic/cloud-sdk-go/pkg/api"
+	"github.com/elastic/cloud-sdk-go/pkg/api/deploymentapi/extensionapi"
+	"github.com/elastic/cloud-sdk-go/pkg/models"
+
+	"github.com/elastic/terraform-provider-ec/ec/internal"
+)
+
+type DataSource struct {
+	client *api.API
+}
+
+var _ datasource.DataSource = &DataSource{}
+var _ datasource.DataSourceWithConfigure = &DataSource{}
+
+func (d *DataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Use this data source to retrieve the deployment extensions of the account, and the deployments using them.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "Filter the result by the exact extension name.",
+				Optional:    true,
+			},
+			"extension_type": schema.StringAttribute{
+				Description: "Filter the result by extension type. Must be `bundle` or `plugin`.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(models.ExtensionExtensionTypeBundle, models.ExtensionExtensionTypePlugin),
+				},
+			},
+			"version": schema.StringAttribute{
+				Description: "Filter the result by Elastic stack version. Either a full version (e.g `8.7.0`), which matches the extensions compatible with that version, or a wildcard pattern (e.g `8.*`), which matches the extensions whose version matches the pattern.",
+				Optional:    true,
+			},
+
+			// computed fields
+			"extensions": extensionsSchema(),
+		},
+	}
+}
+
+func extensionsSchema() schema.Attribute {
+	return schema.ListNestedAttribute{
+		Description: "The extensions matching the filters.",
+		Computed:    true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: extensionAttributes(),
+		},
+	}
+}
+
+func extensionAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "The ID of the extension.",
+			Computed:    true,
+		},
+		"name": schema.StringAttribute{
+			Description: "The name of the extension.",
+			Computed:    true,
+		},
+		"description": schema.StringAttribute{
+			Description: "The description of the extension.",
+			Computed:    true,
+		},
+		"extension_type": schema.StringAttribute{
+			Description: "The extension type, `bundle` or `plugin`.",
+			Computed:    true,
+		},
+		"version": schema.StringAttribute{
+			Description: "The Elastic stack version the extension is compatible with.",
+			Computed:    true,
+		},
+		"download_url": schema.StringAttribute{
+			Description: "The URL the extension archive is downloaded from.",
+			Computed:    true,
+		},
+		"url": schema.StringAttribute{
+			Description: "The extension URL to use in the Elastic Cloud deployment plan.",
+			Computed:    true,
+		},
+		"last_modified": schema.StringAttribute{
+			Description: "The datetime the extension file was last modified.",
+			Computed:    true,
+		},
+		"size": schema.Int64Attribute{
+			Description: "The size of the extension file in bytes.",
+			Computed:    true,
+		},
+		"deployments": schema.ListAttribute{
+			Description: "The IDs of the deployments using the extension.",
+			ElementType: types.StringType,
+			Computed:    true,
+		},
+	}
+}
+
+func (d DataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	// Prevent panic if the provider has not been configured.
+	if d.client == nil {
+		response.Diagnostics.AddError(
+			"Unconfigured API Client",
+			"Expected configured API client. Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	var newState modelV0
+	response.Diagnostics.Append(request.Config.Get(ctx, &newState)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := extensionapi.List(extensionapi.ListParams{
+		API: d.client,
+	})
+	if err != nil {
+		response.Diagnostics.AddError(
+			"Failed retrieving extensions",
+			fmt.Sprintf("Failed retrieving extensions: %s", err),
+		)
+		return
+	}
+
+	// The list doesn't include the deployments using each extension, get
+	// them for the matching extensions only.
+	var extensions []*models.Extension
+	for _, extension := range filterExtensions(res, newState) {
+		res, err := extensionapi.Get(extensionapi.GetParams{
+			API:                d.client,
+			ExtensionID:        *extension.ID,
+			IncludeDeployments: true,
+		})
+		if err != nil {
+			response.Diagnostics.AddError(
+				"Failed retrieving extension",
+				fmt.Sprintf("Failed retrieving extension %s: %s", *extension.ID, err),
+			)
+			return
+		}
+		extensions = append(extensions, res)
+	}
+
+	response.Diagnostics.Append(modelToState(ctx, extensions, &newState)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Finally, set the state
+	response.Diagnostics.Append(response.State.Set(ctx, newState)...)
+}
+
+func (d *DataSource) Metadata(ctx context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_deployment_extensions"
+}
+
+func (d *DataSource) Configure(ctx context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
+	clients, diags := internal.ConvertProviderData(request.ProviderData)
+	response.Diagnostics.Append(diags...)
+	d.client = clients.Stateful
+}
+
+type modelV0 struct {
+	Name          types.String `tfsdk:"name"`
+	ExtensionType types.String `tfsdk:"extension_type"`
+	Version       types.String `tfsdk:"version"`
+	Extensions    types.List   `tfsdk:"extensions"` //< extensionModelV0
+}
+
+type extensionModelV0 struct {
+	ID            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	Description   types.String `tfsdk:"description"`
+	ExtensionType types.String `tfsdk:"extension_type"`
+	Version       types.String `tfsdk:"version"`
+	DownloadURL   types.String `tfsdk:"download_url"`
+	URL           types.String `tfsdk:"url"`
+	LastModified  types.String `tfsdk:"last_modified"`
+	Size          types.Int64  `tfsdk:"size"`
+	Deployments   []string     `tfsdk:"deployments"`
+}
+
+// filterExtensions returns the extensions matching all the filters set in state.
+func filterExtensions(res *models.Extensions, state modelV0) []*models.Extension {
+	var result []*models.Extension
+	for _, extension := range res.Extensions {
+		if extension == nil || extension.ID == nil {
+			continue
+		}
+
+		if !state.Name.IsNull() && stringValue(extension.Name) != state.Name.ValueString() {
+			continue
+		}
+
+		if !state.ExtensionType.IsNull() && stringValue(extension.ExtensionType) != state.ExtensionType.ValueString() {
+			continue
+		}
+
+		if !state.Version.IsNull() && !versionMatches(stringValue(extension.Version), state.Version.ValueString()) {
+			continue
+		}
+
+		result = append(result, extension)
+	}
+	return result
+}
+
+// versionMatches returns true when the extension version, which may itself be
+// a wildcard pattern (e.g `8.*`), matches the given version or version pattern.
+func versionMatches(extensionVersion, version string) bool {
+	if matched, _ := path.Match(version, extensionVersion); matched {
+		return true
+	}
+	matched, _ := path.Match(extensionVersion, version)
+	return matched
+}
+
+func modelToState(ctx context.Context, extensions []*models.Extension, state *modelV0) diag.Diagnostics {
+	var diags diag.Diagnostics
+	result := make([]extensionModelV0, 0, len(extensions))
+	for _, extension := range extensions {
+		result = append(result, extensionToModel(extension))
+	}
+
+	state.Extensions, diags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: extensionAttrTypes()}, result)
+	return diags
+}
+
+func extensionToModel(extension *models.Extension) extensionModelV0 {
+	m := extensionModelV0{
+		ID:            types.StringValue(stringValue(extension.ID)),
+		Name:          types.StringValue(stringValue(extension.Name)),
+		Description:   types.StringValue(extension.Description),
+		ExtensionType: types.StringValue(stringValue(extension.ExtensionType)),
+		Version:       types.StringValue(stringValue(extension.Version)),
+		DownloadURL:   types.StringValue(extension.DownloadURL),
+		URL:           types.StringValue(stringValue(extension.URL)),
+		LastModified:  types.StringNull(),
+		Size:          types.Int64Null(),
+		Deployments:   make([]string, 0, len(extension.Deployments)),
+	}
+
+	if metadata := extension.FileMetadata; metadata != nil {
+		m.LastModified = types.StringValue(metadata.LastModifiedDate.String())
+		m.Size = types.Int64Value(metadata.Size)
+	}
+
+	m.Deployments = append(m.Deployments, extension.Deployments...)
+
+	return m
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func extensionAttrTypes() map[string]attr.Type {
+	return extensionsSchema().GetType().(types.ListType).ElemType.(types.ObjectType).AttrTypes
+}
@@ -0,0 +1,158 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package extensiondatasource
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/cloud-sdk-go/pkg/models"
+)
+
+func Test_filterExtensions(t *testing.T) {
+	extensions := &models.Extensions{
+		Extensions: []*models.Extension{
+			{ID: new("synonyms-8"), Name: new("synonyms"), ExtensionType: new("bundle"), Version: new("8.*")},
+			{ID: new("synonyms-7"), Name: new("synonyms"), ExtensionType: new("bundle"), Version: new("7.*")},
+			{ID: new("analysis"), Name: new("analysis"), ExtensionType: new("plugin"), Version: new("8.7.0")},
+			{ID: new("any"), Name: new("any"), ExtensionType: new("bundle"), Version: new("*")},
+		},
+	}
+
+	ids := func(extensions []*models.Extension) []string {
+		result := []string{}
+		for _, extension := range extensions {
+			result = append(result, *extension.ID)
+		}
+		return result
+	}
+
+	tests := []struct {
+		name  string
+		state modelV0
+		want  []string
+	}{
+		{
+			name: "returns all the extensions without filters",
+			want: []string{"synonyms-8", "synonyms-7", "analysis", "any"},
+		},
+		{
+			name:  "filters by name",
+			state: modelV0{Name: types.StringValue("synonyms")},
+			want:  []string{"synonyms-8", "synonyms-7"},
+		},
+		{
+			name:  "filters by type",
+			state: modelV0{ExtensionType: types.StringValue("plugin")},
+			want:  []string{"analysis"},
+		},
+		{
+			name:  "filters by full version",
+			state: modelV0{Version: types.StringValue("8.7.0")},
+			want:  []string{"synonyms-8", "analysis", "any"},
+		},
+		{
+			name:  "filters by version pattern",
+			state: modelV0{Version: types.StringValue("7.*")},
+			want:  []string{"synonyms-7", "any"},
+		},
+		{
+			name: "combines the filters",
+			state: modelV0{
+				Name:          types.StringValue("synonyms"),
+				ExtensionType: types.StringValue("bundle"),
+				Version:       types.StringValue("8.15.0"),
+			},
+			want: []string{"synonyms-8"},
+		},
+		{
+			name:  "returns nothing when no extension matches",
+			state: modelV0{Name: types.StringValue("missing")},
+			want:  []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ids(filterExtensions(extensions, tt.state)))
+		})
+	}
+}
+
+func Test_modelToState(t *testing.T) {
+	lastModified := strfmt.DateTime{}
+	require.NoError(t, lastModified.UnmarshalText([]byte("2024-01-01T00:00:00.000Z")))
+
+	extensions := []*models.Extension{
+		{
+			ID:            new("synonyms"),
+			Name:          new("synonyms"),
+			Description:   "my synonyms",
+			ExtensionType: new("bundle"),
+			Version:       new("8.*"),
+			URL:           new("repo://1234"),
+			FileMetadata:  &models.ExtensionFileMetadata{LastModifiedDate: lastModified, Size: 42},
+			Deployments:   []string{"deployment-1", "deployment-2"},
+		},
+		{
+			ID:            new("unused"),
+			Name:          new("unused"),
+			ExtensionType: new("bundle"),
+			Version:       new("*"),
+			DownloadURL:   "https://example.com/unused.zip",
+			URL:           new("https://example.com/unused.zip"),
+		},
+	}
+
+	var state modelV0
+	diags := modelToState(context.Background(), extensions, &state)
+	require.False(t, diags.HasError(), diags)
+
+	var got []extensionModelV0
+	require.False(t, state.Extensions.ElementsAs(context.Background(), &got, false).HasError())
+	assert.Equal(t, []extensionModelV0{
+		{
+			ID:            types.StringValue("synonyms"),
+			Name:          types.StringValue("synonyms"),
+			Description:   types.StringValue("my synonyms"),
+			ExtensionType: types.StringValue("bundle"),
+			Version:       types.StringValue("8.*"),
+			DownloadURL:   types.StringValue(""),
+			URL:           types.StringValue("repo://1234"),
+			LastModified:  types.StringValue(lastModified.String()),
+			Size:          types.Int64Value(42),
+			Deployments:   []string{"deployment-1", "deployment-2"},
+		},
+		{
+			ID:            types.StringValue("unused"),
+			Name:          types.StringValue("unused"),
+			Description:   types.StringValue(""),
+			ExtensionType: types.StringValue("bundle"),
+			Version:       types.StringValue("*"),
+			DownloadURL:   types.StringValue("https://example.com/unused.zip"),
+			URL:           types.StringValue("https://example.com/unused.zip"),
+			LastModified:  types.StringNull(),
+			Size:          types.Int64Null(),
+			Deployments:   []string{},
+		},
+	}, got)
+}
@@ -0,0 +1,106 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package extensiondatasource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+
+	"github.com/elastic/cloud-sdk-go/pkg/api"
+	"github.com/elastic/cloud-sdk-go/pkg/api/deploymentapi/extensionapi"
+	"github.com/elastic/cloud-sdk-go/pkg/client/extensions"
+
+	"github.com/elastic/terraform-provider-ec/ec/internal"
+)
+
+type ExtensionDataSource struct {
+	client *api.API
+}
+
+var _ datasource.DataSource = &ExtensionDataSource{}
+var _ datasource.DataSourceWithConfigure = &ExtensionDataSource{}
+
+func (d *ExtensionDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := extensionAttributes()
+	attributes["id"] = schema.StringAttribute{
+		Description: "The ID of the extension.",
+		Required:    true,
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Use this data source to retrieve an existing deployment extension, and the deployments using it.",
+		Attributes:  attributes,
+	}
+}
+
+func (d ExtensionDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	// Prevent panic if the provider has not been configured.
+	if d.client == nil {
+		response.Diagnostics.AddError(
+			"Unconfigured API Client",
+			"Expected configured API client. Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	var newState extensionModelV0
+	response.Diagnostics.Append(request.Config.Get(ctx, &newState)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := extensionapi.Get(extensionapi.GetParams{
+		API:                d.client,
+		ExtensionID:        newState.ID.ValueString(),
+		IncludeDeployments: true,
+	})
+	if err != nil {
+		var notFound *extensions.GetExtensionNotFound
+		if errors.As(err, &notFound) {
+			response.Diagnostics.AddError(
+				"Extension not found",
+				fmt.Sprintf("No extension with ID %s was found.", newState.ID.ValueString()),
+			)
+			return
+		}
+
+		response.Diagnostics.AddError(
+			"Failed retrieving extension",
+			fmt.Sprintf("Failed retrieving extension %s: %s", newState.ID.ValueString(), err),
+		)
+		return
+	}
+
+	// Finally, set the state
+	response.Diagnostics.Append(response.State.Set(ctx, extensionToModel(res))...)
+}
+
+func (d *ExtensionDataSource) Metadata(ctx context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_deployment_extension"
+}
+
+func (d *ExtensionDataSource) Configure(ctx context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
+	clients, diags := internal.ConvertProviderData(request.ProviderData)
+	response.Diagnostics.Append(diags...)
+	d.client = clients.Stateful
+}
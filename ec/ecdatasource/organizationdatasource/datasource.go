@@ -0,0 +1,233 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package organizationdatasource
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/elastic/cloud-sdk-go/pkg/api"
+	"github.com/elastic/cloud-sdk-go/pkg/api/organizationapi"
+	"github.com/elastic/cloud-sdk-go/pkg/models"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/elastic/terraform-provider-ec/ec/ecresource/organizationresource"
+	"github.com/elastic/terraform-provider-ec/ec/internal"
+)
+
+var _ datasource.DataSource = &DataSource{}
+var _ datasource.DataSourceWithConfigure = &DataSource{}
+
+type DataSource struct {
+	client *api.API
+}
+
+func (d *DataSource) Configure(ctx context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
+	clients, diags := internal.ConvertProviderData(request.ProviderData)
+	response.Diagnostics.Append(diags...)
+	d.client = clients.Stateful
+}
+
+func (d *DataSource) Metadata(ctx context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_organization"
+}
+
+func (d DataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	// Prevent panic if the provider has not been configured.
+	if d.client == nil {
+		response.Diagnostics.AddError(
+			"Unconfigured API Client",
+			"Expected configured API client. Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	var newState modelV0
+	response.Diagnostics.Append(request.Config.Get(ctx, &newState)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	organization := d.organization(newState.ID.ValueString(), &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	members, err := organizationapi.ListMembers(organizationapi.ListMembersParams{
+		API:            d.client,
+		OrganizationID: *organization.ID,
+	})
+	if err != nil {
+		response.Diagnostics.AddError("Listing organization members failed", err.Error())
+		return
+	}
+
+	invitations, err := organizationapi.ListInvitations(organizationapi.ListInvitationsParams{
+		API:            d.client,
+		OrganizationID: *organization.ID,
+	})
+	if err != nil {
+		response.Diagnostics.AddError("Listing organization invitations failed", err.Error())
+		return
+	}
+
+	response.Diagnostics.Append(modelToState(ctx, organization, members.Members, invitations.Invitations, &newState)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Finally, set the state
+	response.Diagnostics.Append(response.State.Set(ctx, newState)...)
+}
+
+// organization returns the organization with the given ID, or the
+// organization of the API key when id is empty.
+func (d DataSource) organization(id string, diagnostics *diag.Diagnostics) *models.Organization {
+	if id != "" {
+		organization, err := organizationapi.Get(organizationapi.GetParams{API: d.client, OrganizationID: id})
+		if err != nil {
+			diagnostics.AddError("Failed retrieving organization", err.Error())
+			return nil
+		}
+		return organization
+	}
+
+	organizations, err := organizationapi.List(organizationapi.ListParams{API: d.client})
+	if err != nil {
+		diagnostics.AddError("Listing organizations failed", err.Error())
+		return nil
+	}
+	if len(organizations) == 0 || organizations[0].ID == nil {
+		diagnostics.AddError("Organization not found", "The API key doesn't belong to any organization, set id.")
+		return nil
+	}
+	return organizations[0]
+}
+
+func modelToState(
+	ctx context.Context,
+	organization *models.Organization,
+	apiMembers []*models.OrganizationMembership,
+	apiInvitations []*models.OrganizationInvitation,
+	state *modelV0,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	state.ID = types.StringPointerValue(organization.ID)
+	state.Name = types.StringPointerValue(organization.Name)
+
+	var members []organizationresource.OrganizationMember
+	for _, apiMember := range apiMembers {
+		member := organizationresource.MemberToModel(ctx, *apiMember, &diags)
+		if diags.HasError() {
+			return diags
+		}
+		members = append(members, *member)
+	}
+
+	var invitations []organizationresource.OrganizationMember
+	for _, apiInvitation := range apiInvitations {
+		invitation := organizationresource.InvitationToModel(ctx, apiInvitation, &diags)
+		if diags.HasError() {
+			return diags
+		}
+		invitations = append(invitations, *invitation)
+	}
+
+	state.Members = membersToList(ctx, filter(ctx, members, *state, &diags), &diags)
+	state.Invitations = membersToList(ctx, filter(ctx, invitations, *state, &diags), &diags)
+	return diags
+}
+
+func membersToList(ctx context.Context, members []organizationresource.OrganizationMember, diags *diag.Diagnostics) types.List {
+	slices.SortFunc(members, func(a, b organizationresource.OrganizationMember) int {
+		return strings.Compare(a.Email.ValueString(), b.Email.ValueString())
+	})
+
+	list, d := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: memberAttrTypes()}, members)
+	diags.Append(d...)
+	return list
+}
+
+// filter returns the members matching all the filters of the configuration.
+func filter(ctx context.Context, members []organizationresource.OrganizationMember, config modelV0, diags *diag.Diagnostics) []organizationresource.OrganizationMember {
+	result := make([]organizationresource.OrganizationMember, 0, len(members))
+	for _, member := range members {
+		deploymentRoles := elementsAs[organizationresource.DeploymentRoleAssignment](ctx, member.DeploymentRoles, diags)
+		var projectRoles []organizationresource.ProjectRoleAssignment
+		for _, roles := range []types.Set{member.ProjectElasticsearchRoles, member.ProjectObservabilityRoles, member.ProjectSecurityRoles} {
+			projectRoles = append(projectRoles, elementsAs[organizationresource.ProjectRoleAssignment](ctx, roles, diags)...)
+		}
+		if diags.HasError() {
+			return nil
+		}
+
+		if role := config.Role.ValueString(); role != "" && !hasRole(member, deploymentRoles, projectRoles, role) {
+			continue
+		}
+		if id := config.DeploymentID.ValueString(); id != "" && !slices.ContainsFunc(deploymentRoles, func(r organizationresource.DeploymentRoleAssignment) bool {
+			return r.ForAllDeployments.ValueBool() || containsString(ctx, r.DeploymentIDs, id, diags)
+		}) {
+			continue
+		}
+		if id := config.ProjectID.ValueString(); id != "" && !slices.ContainsFunc(projectRoles, func(r organizationresource.ProjectRoleAssignment) bool {
+			return r.ForAllProjects.ValueBool() || containsString(ctx, r.ProjectIDs, id, diags)
+		}) {
+			continue
+		}
+
+		result = append(result, member)
+	}
+	return result
+}
+
+func hasRole(
+	member organizationresource.OrganizationMember,
+	deploymentRoles []organizationresource.DeploymentRoleAssignment,
+	projectRoles []organizationresource.ProjectRoleAssignment,
+	role string,
+) bool {
+	if member.OrganizationRole.ValueString() == role {
+		return true
+	}
+	if slices.ContainsFunc(deploymentRoles, func(r organizationresource.DeploymentRoleAssignment) bool {
+		return r.Role.ValueString() == role
+	}) {
+		return true
+	}
+	return slices.ContainsFunc(projectRoles, func(r organizationresource.ProjectRoleAssignment) bool {
+		return r.Role.ValueString() == role
+	})
+}
+
+func elementsAs[T any](ctx context.Context, set types.Set, diags *diag.Diagnostics) []T {
+	var result []T
+	if set.IsNull() || set.IsUnknown() {
+		return result
+	}
+	diags.Append(set.ElementsAs(ctx, &result, false)...)
+	return result
+}
+
+func containsString(ctx context.Context, set types.Set, value string, diags *diag.Diagnostics) bool {
+	return slices.Contains(elementsAs[string](ctx, set, diags), value)
+}
@@ -0,0 +1,158 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package organizationdatasource
+
+import (
+	"context"
+	"testing"
+
+	"github.com/elastic/cloud-sdk-go/pkg/models"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/terraform-provider-ec/ec/ecresource/organizationresource"
+)
+
+func Test_modelToState(t *testing.T) {
+	ctx := context.Background()
+
+	organization := &models.Organization{ID: new("123"), Name: new("my-org")}
+	members := []*models.OrganizationMembership{
+		{
+			Email:          "viewer@example.com",
+			OrganizationID: new("123"),
+			UserID:         new("viewer"),
+			RoleAssignments: &models.RoleAssignments{
+				Deployment: []*models.DeploymentRoleAssignment{
+					{OrganizationID: new("123"), RoleID: new("deployment-viewer"), DeploymentIds: []string{"abc"}},
+				},
+			},
+		},
+		{
+			Email:          "admin@example.com",
+			OrganizationID: new("123"),
+			UserID:         new("admin"),
+			RoleAssignments: &models.RoleAssignments{
+				Organization: []*models.OrganizationRoleAssignment{
+					{OrganizationID: new("123"), RoleID: new("organization-admin")},
+				},
+			},
+		},
+		{
+			Email:          "developer@example.com",
+			OrganizationID: new("123"),
+			UserID:         new("developer"),
+			RoleAssignments: &models.RoleAssignments{
+				Project: &models.ProjectRoleAssignments{
+					Elasticsearch: []*models.ProjectRoleAssignment{
+						{OrganizationID: new("123"), RoleID: new("elasticsearch-developer"), All: new(true)},
+					},
+				},
+			},
+		},
+	}
+	invitations := []*models.OrganizationInvitation{
+		{
+			Email:        new("editor@example.com"),
+			Organization: &models.Organization{ID: new("123")},
+			Token:        new("token"),
+			RoleAssignments: &models.RoleAssignments{
+				Deployment: []*models.DeploymentRoleAssignment{
+					{OrganizationID: new("123"), RoleID: new("deployment-editor"), All: new(true)},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name                string
+		config              modelV0
+		expectedMembers     []string
+		expectedInvitations []string
+	}{
+		{
+			name:                "returns all members and invitations sorted by email",
+			expectedMembers:     []string{"admin@example.com", "developer@example.com", "viewer@example.com"},
+			expectedInvitations: []string{"editor@example.com"},
+		},
+		{
+			name:                "filters by organization role",
+			config:              modelV0{Role: types.StringValue("organization-admin")},
+			expectedMembers:     []string{"admin@example.com"},
+			expectedInvitations: []string{},
+		},
+		{
+			name:                "filters by deployment role",
+			config:              modelV0{Role: types.StringValue("editor")},
+			expectedMembers:     []string{},
+			expectedInvitations: []string{"editor@example.com"},
+		},
+		{
+			name:                "filters by deployment, including the roles on all deployments",
+			config:              modelV0{DeploymentID: types.StringValue("abc")},
+			expectedMembers:     []string{"viewer@example.com"},
+			expectedInvitations: []string{"editor@example.com"},
+		},
+		{
+			name:                "filters by project, including the roles on all projects",
+			config:              modelV0{ProjectID: types.StringValue("xyz")},
+			expectedMembers:     []string{"developer@example.com"},
+			expectedInvitations: []string{},
+		},
+		{
+			name:                "combines the filters",
+			config:              modelV0{Role: types.StringValue("viewer"), DeploymentID: types.StringValue("def")},
+			expectedMembers:     []string{},
+			expectedInvitations: []string{},
+		},
+	}
+
+	var schemaResp datasource.SchemaResponse
+	(&DataSource{}).Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError())
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := tt.config
+			diags := modelToState(ctx, organization, members, invitations, &state)
+			require.False(t, diags.HasError(), diags)
+
+			require.Equal(t, types.StringValue("123"), state.ID)
+			require.Equal(t, types.StringValue("my-org"), state.Name)
+			require.Equal(t, tt.expectedMembers, emails(t, state.Members))
+			require.Equal(t, tt.expectedInvitations, emails(t, state.Invitations))
+
+			// The state must match the schema of the data source.
+			tfState := tfsdk.State{Schema: schemaResp.Schema}
+			require.False(t, tfState.Set(ctx, state).HasError())
+		})
+	}
+}
+
+func emails(t *testing.T, list types.List) []string {
+	var members []organizationresource.OrganizationMember
+	require.False(t, list.ElementsAs(context.Background(), &members, false).HasError())
+
+	result := make([]string, 0, len(members))
+	for _, member := range members {
+		result = append(result, member.Email.ValueString())
+	}
+	return result
+}
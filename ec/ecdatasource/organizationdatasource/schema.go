@@ -0,0 +1,167 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package organizationdatasource
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func (d *DataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Use this data source to retrieve the members of an organization, the pending invitations and their role assignments.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the organization. Defaults to the organization of the API key.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"role": schema.StringAttribute{
+				Description: "Only return the members and invitations with this role, e.g. `organization-admin`, or a deployment or project role such as `viewer`.",
+				Optional:    true,
+			},
+			"deployment_id": schema.StringAttribute{
+				Description: "Only return the members and invitations with a role on this deployment, including the roles on all deployments.",
+				Optional:    true,
+			},
+			"project_id": schema.StringAttribute{
+				Description: "Only return the members and invitations with a role on this serverless project, including the roles on all projects.",
+				Optional:    true,
+			},
+
+			// computed fields
+			"name": schema.StringAttribute{
+				Description: "Name of the organization.",
+				Computed:    true,
+			},
+			"members":     membersSchema("Members of the organization, sorted by email. Each member is listed with all its role assignments."),
+			"invitations": membersSchema("Pending invitations to join the organization, sorted by email. Each invitation is listed with all the role assignments of the invited user."),
+		},
+	}
+}
+
+func membersSchema(description string) schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		Description: description,
+		Computed:    true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"email": schema.StringAttribute{
+					Description: "Email address of the user.",
+					Computed:    true,
+				},
+				"invitation_pending": schema.BoolAttribute{
+					Description: "Set to true while the user has not yet accepted their invitation to the organization.",
+					Computed:    true,
+				},
+				"invitation_expires_at": schema.StringAttribute{
+					Description: "Date and time (RFC 3339) when the pending invitation of the user expires.",
+					Computed:    true,
+				},
+				"invited_at": schema.StringAttribute{
+					Description: "Date and time (RFC 3339) when the user was invited, while the invitation is pending.",
+					Computed:    true,
+				},
+				"user_id": schema.StringAttribute{
+					Description: "User ID, once the user accepted the invitation.",
+					Computed:    true,
+				},
+				"organization_role": schema.StringAttribute{
+					Description: "The organization role of the user, one of `organization-admin` or `billing-admin`.",
+					Computed:    true,
+				},
+				"deployment_roles": schema.SetNestedAttribute{
+					Description: "The roles of the user on deployments.",
+					Computed:    true,
+					NestedObject: schema.NestedAttributeObject{
+						Attributes: map[string]schema.Attribute{
+							"role": schema.StringAttribute{
+								Description: "Assigned role, one of `viewer`, `editor` or `admin`.",
+								Computed:    true,
+							},
+							"all_deployments": schema.BoolAttribute{
+								Description: "Role applies to all deployments in the organization.",
+								Computed:    true,
+							},
+							"deployment_ids": schema.SetAttribute{
+								Description: "Role applies to deployments listed here.",
+								Computed:    true,
+								ElementType: types.StringType,
+							},
+							"application_roles": schema.SetAttribute{
+								Description: "Application roles granted to the user when signing in to the deployments.",
+								Computed:    true,
+								ElementType: types.StringType,
+							},
+						},
+					},
+				},
+				"project_elasticsearch_roles": projectRolesSchema("The roles of the user on elasticsearch projects."),
+				"project_observability_roles": projectRolesSchema("The roles of the user on observability projects."),
+				"project_security_roles":      projectRolesSchema("The roles of the user on security projects."),
+			},
+		},
+	}
+}
+
+func projectRolesSchema(description string) schema.SetNestedAttribute {
+	return schema.SetNestedAttribute{
+		Description: description,
+		Computed:    true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"role": schema.StringAttribute{
+					Description: "Assigned role.",
+					Computed:    true,
+				},
+				"all_projects": schema.BoolAttribute{
+					Description: "Role applies to all projects in the organization.",
+					Computed:    true,
+				},
+				"project_ids": schema.SetAttribute{
+					Description: "Role applies to projects listed here.",
+					Computed:    true,
+					ElementType: types.StringType,
+				},
+				"application_roles": schema.SetAttribute{
+					Description: "Application roles granted to the user when signing in to the projects.",
+					Computed:    true,
+					ElementType: types.StringType,
+				},
+			},
+		},
+	}
+}
+
+func memberAttrTypes() map[string]attr.Type {
+	return membersSchema("").GetType().(types.ListType).ElemType.(types.ObjectType).AttrTypes
+}
+
+type modelV0 struct {
+	ID           types.String `tfsdk:"id"`
+	Role         types.String `tfsdk:"role"`
+	DeploymentID types.String `tfsdk:"deployment_id"`
+	ProjectID    types.String `tfsdk:"project_id"`
+	Name         types.String `tfsdk:"name"`
+	Members      types.List   `tfsdk:"members"`     //< organizationresource.OrganizationMember
+	Invitations  types.List   `tfsdk:"invitations"` //< organizationresource.OrganizationMember
+}
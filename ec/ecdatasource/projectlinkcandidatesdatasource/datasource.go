@@ -0,0 +1,193 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package projectlinkcandidatesdatasource
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/elastic/terraform-provider-ec/ec/internal"
+	"github.com/elastic/terraform-provider-ec/ec/internal/gen/serverless"
+)
+
+var _ datasource.DataSource = &DataSource{}
+var _ datasource.DataSourceWithConfigure = &DataSource{}
+
+type DataSource struct {
+	client serverless.ClientWithResponsesInterface
+}
+
+func (d *DataSource) Configure(ctx context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
+	clients, diags := internal.ConvertProviderData(request.ProviderData)
+	response.Diagnostics.Append(diags...)
+	d.client = clients.Serverless
+}
+
+func (d *DataSource) Metadata(ctx context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_project_link_candidates"
+}
+
+func (d DataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	// Prevent panic if the provider has not been configured.
+	if d.client == nil {
+		response.Diagnostics.AddError(
+			"Unconfigured API Client",
+			"Expected configured API client. Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	var newState modelV0
+	response.Diagnostics.Append(request.Config.Get(ctx, &newState)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	filter, diags := expandFilter(ctx, newState)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	candidates, err := listCandidates(ctx, d.client, newState.ProjectType.ValueString(), newState.ProjectID.ValueString(), filter)
+	if err != nil {
+		response.Diagnostics.AddError(
+			"Failed retrieving project link candidates",
+			fmt.Sprintf("Failed retrieving link candidates for project %s: %s", newState.ProjectID.ValueString(), err),
+		)
+		return
+	}
+
+	response.Diagnostics.Append(modelToState(ctx, candidates, &newState)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Finally, set the state
+	response.Diagnostics.Append(response.State.Set(ctx, newState)...)
+}
+
+// candidateFilter holds the query parameters shared by the link candidate
+// endpoints of every project type.
+type candidateFilter struct {
+	Types  *[]serverless.ProjectType
+	Csp    *string
+	Region *string
+	Name   *string
+	Alias  *string
+}
+
+func expandFilter(ctx context.Context, model modelV0) (candidateFilter, diag.Diagnostics) {
+	var filter candidateFilter
+
+	if !model.Types.IsNull() && !model.Types.IsUnknown() {
+		var typeNames []string
+		if diags := model.Types.ElementsAs(ctx, &typeNames, false); diags.HasError() {
+			return filter, diags
+		}
+
+		candidateTypes := make([]serverless.ProjectType, 0, len(typeNames))
+		for _, t := range typeNames {
+			candidateTypes = append(candidateTypes, serverless.ProjectType(t))
+		}
+		filter.Types = &candidateTypes
+	}
+
+	filter.Csp = model.Csp.ValueStringPointer()
+	filter.Region = model.Region.ValueStringPointer()
+	filter.Name = model.Name.ValueStringPointer()
+	filter.Alias = model.Alias.ValueStringPointer()
+
+	return filter, nil
+}
+
+func listCandidates(ctx context.Context, client serverless.ClientWithResponsesInterface, projectType, id string, filter candidateFilter) ([]serverless.LinkedCandidateProject, error) {
+	var (
+		list       *serverless.LinkedCandidatesList
+		statusCode int
+		status     string
+		body       []byte
+	)
+
+	switch projectType {
+	case "elasticsearch":
+		resp, err := client.GetElasticsearchProjectLinkCandidatesWithResponse(ctx, id, &serverless.GetElasticsearchProjectLinkCandidatesParams{
+			Types: filter.Types, Csp: filter.Csp, Region: filter.Region, Name: filter.Name, Alias: filter.Alias,
+		})
+		if err != nil {
+			return nil, err
+		}
+		list, statusCode, status, body = resp.JSON200, resp.StatusCode(), resp.Status(), resp.Body
+	case "observability":
+		resp, err := client.GetObservabilityProjectLinkCandidatesWithResponse(ctx, id, &serverless.GetObservabilityProjectLinkCandidatesParams{
+			Types: filter.Types, Csp: filter.Csp, Region: filter.Region, Name: filter.Name, Alias: filter.Alias,
+		})
+		if err != nil {
+			return nil, err
+		}
+		list, statusCode, status, body = resp.JSON200, resp.StatusCode(), resp.Status(), resp.Body
+	case "security":
+		resp, err := client.GetSecurityProjectLinkCandidatesWithResponse(ctx, id, &serverless.GetSecurityProjectLinkCandidatesParams{
+			Types: filter.Types, Csp: filter.Csp, Region: filter.Region, Name: filter.Name, Alias: filter.Alias,
+		})
+		if err != nil {
+			return nil, err
+		}
+		list, statusCode, status, body = resp.JSON200, resp.StatusCode(), resp.Status(), resp.Body
+	default:
+		return nil, fmt.Errorf("unsupported project type %q", projectType)
+	}
+
+	if list == nil {
+		return nil, fmt.Errorf("the API request failed with: %d %s\n%s", statusCode, status, body)
+	}
+
+	return list.Items, nil
+}
+
+func modelToState(ctx context.Context, candidates []serverless.LinkedCandidateProject, state *modelV0) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	result := make([]candidateModelV0, 0, len(candidates))
+	for _, candidate := range candidates {
+		tags, d := types.MapValueFrom(ctx, types.StringType, map[string]string(candidate.Tags))
+		diags.Append(d...)
+
+		result = append(result, candidateModelV0{
+			ID:     types.StringValue(candidate.Id),
+			Name:   types.StringValue(candidate.Name),
+			Alias:  types.StringValue(candidate.Alias),
+			Type:   types.StringValue(string(candidate.Type)),
+			Csp:    types.StringValue(candidate.Csp),
+			Region: types.StringValue(candidate.Region),
+			Linked: types.BoolValue(candidate.Linked),
+			Tags:   tags,
+		})
+	}
+
+	candidatesList, d := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: candidateAttrTypes()}, result)
+	diags.Append(d...)
+	state.Candidates = candidatesList
+
+	return diags
+}
@@ -0,0 +1,155 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package projectlinkcandidatesdatasource
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/elastic/terraform-provider-ec/ec/internal/gen/serverless"
+	"github.com/elastic/terraform-provider-ec/ec/internal/gen/serverless/mocks"
+)
+
+func Test_expandFilter(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("returns an empty filter when nothing is configured", func(t *testing.T) {
+		filter, diags := expandFilter(ctx, modelV0{
+			Types:  types.SetNull(types.StringType),
+			Csp:    types.StringNull(),
+			Region: types.StringNull(),
+			Name:   types.StringNull(),
+			Alias:  types.StringNull(),
+		})
+		require.False(t, diags.HasError())
+		require.Equal(t, candidateFilter{}, filter)
+	})
+
+	t.Run("maps every configured filter", func(t *testing.T) {
+		filter, diags := expandFilter(ctx, modelV0{
+			Types:  types.SetValueMust(types.StringType, []attr.Value{types.StringValue("security")}),
+			Csp:    types.StringValue("aws"),
+			Region: types.StringValue("aws-us-east-1"),
+			Name:   types.StringValue("my-project"),
+			Alias:  types.StringValue("my-alias"),
+		})
+		require.False(t, diags.HasError())
+		require.Equal(t, candidateFilter{
+			Types:  &[]serverless.ProjectType{"security"},
+			Csp:    new("aws"),
+			Region: new("aws-us-east-1"),
+			Name:   new("my-project"),
+			Alias:  new("my-alias"),
+		}, filter)
+	})
+}
+
+func Test_listCandidates(t *testing.T) {
+	ctx := context.Background()
+	items := []serverless.LinkedCandidateProject{{Id: "candidate", Type: "elasticsearch"}}
+	filter := candidateFilter{Region: new("aws-us-east-1")}
+
+	t.Run("lists elasticsearch candidates", func(t *testing.T) {
+		client := mocks.NewMockClientWithResponsesInterface(gomock.NewController(t))
+		client.EXPECT().
+			GetElasticsearchProjectLinkCandidatesWithResponse(ctx, "id", &serverless.GetElasticsearchProjectLinkCandidatesParams{Region: filter.Region}).
+			Return(&serverless.GetElasticsearchProjectLinkCandidatesResponse{
+				HTTPResponse: &http.Response{StatusCode: 200},
+				JSON200:      &serverless.LinkedCandidatesList{Items: items},
+			}, nil)
+
+		got, err := listCandidates(ctx, client, "elasticsearch", "id", filter)
+		require.NoError(t, err)
+		require.Equal(t, items, got)
+	})
+
+	t.Run("lists observability candidates", func(t *testing.T) {
+		client := mocks.NewMockClientWithResponsesInterface(gomock.NewController(t))
+		client.EXPECT().
+			GetObservabilityProjectLinkCandidatesWithResponse(ctx, "id", &serverless.GetObservabilityProjectLinkCandidatesParams{Region: filter.Region}).
+			Return(&serverless.GetObservabilityProjectLinkCandidatesResponse{
+				HTTPResponse: &http.Response{StatusCode: 200},
+				JSON200:      &serverless.LinkedCandidatesList{Items: items},
+			}, nil)
+
+		got, err := listCandidates(ctx, client, "observability", "id", filter)
+		require.NoError(t, err)
+		require.Equal(t, items, got)
+	})
+
+	t.Run("returns an error when the project can't be found", func(t *testing.T) {
+		client := mocks.NewMockClientWithResponsesInterface(gomock.NewController(t))
+		client.EXPECT().
+			GetSecurityProjectLinkCandidatesWithResponse(ctx, "id", &serverless.GetSecurityProjectLinkCandidatesParams{Region: filter.Region}).
+			Return(&serverless.GetSecurityProjectLinkCandidatesResponse{
+				HTTPResponse: &http.Response{StatusCode: 404, Status: "404 Not Found"},
+				Body:         []byte("not found"),
+			}, nil)
+
+		_, err := listCandidates(ctx, client, "security", "id", filter)
+		require.EqualError(t, err, "the API request failed with: 404 404 Not Found\nnot found")
+	})
+
+	t.Run("returns an error for unsupported project types", func(t *testing.T) {
+		client := mocks.NewMockClientWithResponsesInterface(gomock.NewController(t))
+
+		_, err := listCandidates(ctx, client, "workplaceai", "id", filter)
+		require.EqualError(t, err, `unsupported project type "workplaceai"`)
+	})
+}
+
+func Test_modelToState(t *testing.T) {
+	ctx := context.Background()
+
+	state := modelV0{}
+	diags := modelToState(ctx, []serverless.LinkedCandidateProject{
+		{
+			Id:     "candidate-id",
+			Name:   "candidate",
+			Alias:  "candidate-alias",
+			Type:   "observability",
+			Csp:    "aws",
+			Region: "aws-us-east-1",
+			Linked: true,
+			Tags:   serverless.ProjectTags{"team": "platform"},
+		},
+	}, &state)
+	require.False(t, diags.HasError())
+
+	var candidates []candidateModelV0
+	require.False(t, state.Candidates.ElementsAs(ctx, &candidates, false).HasError())
+	require.Len(t, candidates, 1)
+
+	assert.Equal(t, candidateModelV0{
+		ID:     types.StringValue("candidate-id"),
+		Name:   types.StringValue("candidate"),
+		Alias:  types.StringValue("candidate-alias"),
+		Type:   types.StringValue("observability"),
+		Csp:    types.StringValue("aws"),
+		Region: types.StringValue("aws-us-east-1"),
+		Linked: types.BoolValue(true),
+		Tags:   types.MapValueMust(types.StringType, map[string]attr.Value{"team": types.StringValue("platform")}),
+	}, candidates[0])
+}
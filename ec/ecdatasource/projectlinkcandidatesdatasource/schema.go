@@ -0,0 +1,144 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package projectlinkcandidatesdatasource
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var projectTypes = []string{"elasticsearch", "observability", "security"}
+
+func (d *DataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Use this data source to list the serverless projects which can be linked to a project through its `linked.projects` attribute.",
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				Description: "ID of the project to list the link candidates for.",
+				Required:    true,
+			},
+			"project_type": schema.StringAttribute{
+				Description: "Type of the project identified by `project_id`. One of `elasticsearch`, `observability` or `security`.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(projectTypes...),
+				},
+			},
+			"types": schema.SetAttribute{
+				Description: "Only return link candidates of the given project types.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"csp": schema.StringAttribute{
+				Description: "Only return link candidates hosted on the given cloud service provider.",
+				Optional:    true,
+			},
+			"region": schema.StringAttribute{
+				Description: "Only return link candidates in the given region.",
+				Optional:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Only return link candidates with the given name.",
+				Optional:    true,
+			},
+			"alias": schema.StringAttribute{
+				Description: "Only return link candidates with the given alias.",
+				Optional:    true,
+			},
+
+			// computed fields
+			"candidates": candidatesSchema(),
+		},
+	}
+}
+
+func candidatesSchema() schema.Attribute {
+	return schema.ListNestedAttribute{
+		Description: "The projects which can be linked to the project.",
+		Computed:    true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"id": schema.StringAttribute{
+					Description: "The ID of the project.",
+					Computed:    true,
+				},
+				"name": schema.StringAttribute{
+					Description: "The name of the project.",
+					Computed:    true,
+				},
+				"alias": schema.StringAttribute{
+					Description: "The alias of the project.",
+					Computed:    true,
+				},
+				"type": schema.StringAttribute{
+					Description: "The type of the project. Use it as the `type` of the `linked.projects` entry.",
+					Computed:    true,
+				},
+				"csp": schema.StringAttribute{
+					Description: "The cloud service provider hosting the project.",
+					Computed:    true,
+				},
+				"region": schema.StringAttribute{
+					Description: "The region of the project.",
+					Computed:    true,
+				},
+				"linked": schema.BoolAttribute{
+					Description: "Whether the project is already linked to the requesting project.",
+					Computed:    true,
+				},
+				"tags": schema.MapAttribute{
+					Description: "The tags of the project.",
+					ElementType: types.StringType,
+					Computed:    true,
+				},
+			},
+		},
+	}
+}
+
+func candidateAttrTypes() map[string]attr.Type {
+	return candidatesSchema().GetType().(types.ListType).ElemType.(types.ObjectType).AttrTypes
+}
+
+type modelV0 struct {
+	ProjectID   types.String `tfsdk:"project_id"`
+	ProjectType types.String `tfsdk:"project_type"`
+	Types       types.Set    `tfsdk:"types"`
+	Csp         types.String `tfsdk:"csp"`
+	Region      types.String `tfsdk:"region"`
+	Name        types.String `tfsdk:"name"`
+	Alias       types.String `tfsdk:"alias"`
+	Candidates  types.List   `tfsdk:"candidates"` //< candidateModelV0
+}
+
+type candidateModelV0 struct {
+	ID     types.String `tfsdk:"id"`
+	Name   types.String `tfsdk:"name"`
+	Alias  types.String `tfsdk:"alias"`
+	Type   types.String `tfsdk:"type"`
+	Csp    types.String `tfsdk:"csp"`
+	Region types.String `tfsdk:"region"`
+	Linked types.Bool   `tfsdk:"linked"`
+	Tags   types.Map    `tfsdk:"tags"`
+}
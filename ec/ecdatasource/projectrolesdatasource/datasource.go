@@ -0,0 +1,107 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package projectrolesdatasource
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/elastic/terraform-provider-ec/ec/internal"
+	"github.com/elastic/terraform-provider-ec/ec/internal/gen/serverless"
+	"github.com/elastic/terraform-provider-ec/ec/internal/util"
+)
+
+var _ datasource.DataSource = &DataSource{}
+var _ datasource.DataSourceWithConfigure = &DataSource{}
+
+type DataSource struct {
+	client serverless.ClientWithResponsesInterface
+}
+
+func (d *DataSource) Configure(ctx context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
+	clients, diags := internal.ConvertProviderData(request.ProviderData)
+	response.Diagnostics.Append(diags...)
+	d.client = clients.Serverless
+}
+
+func (d *DataSource) Metadata(ctx context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_project_roles"
+}
+
+func (d DataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	// Prevent panic if the provider has not been configured.
+	if d.client == nil {
+		response.Diagnostics.AddError(
+			"Unconfigured API Client",
+			"Expected configured API client. Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	var newState modelV0
+	response.Diagnostics.Append(request.Config.Get(ctx, &newState)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	projectType := newState.ProjectType.ValueString()
+	customRoles, err := util.ListCustomProjectRoles(ctx, d.client, projectType, newState.ProjectID.ValueString())
+	if err != nil {
+		response.Diagnostics.AddError(
+			"Failed retrieving project roles",
+			fmt.Sprintf("Failed retrieving roles for project %s: %s", newState.ProjectID.ValueString(), err),
+		)
+		return
+	}
+
+	response.Diagnostics.Append(modelToState(ctx, util.BuiltInProjectRoles[projectType], customRoles, &newState)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Finally, set the state
+	response.Diagnostics.Append(response.State.Set(ctx, newState)...)
+}
+
+func modelToState(ctx context.Context, builtInRoles []string, customRoles []serverless.ProjectRoleDetails, state *modelV0) diag.Diagnostics {
+	result := make([]roleModelV0, 0, len(builtInRoles)+len(customRoles))
+	for _, name := range builtInRoles {
+		result = append(result, roleModelV0{
+			Name:        types.StringValue(name),
+			Description: types.StringNull(),
+			BuiltIn:     types.BoolValue(true),
+		})
+	}
+	for _, role := range customRoles {
+		result = append(result, roleModelV0{
+			Name:        types.StringValue(role.Name),
+			Description: types.StringValue(role.Description),
+			BuiltIn:     types.BoolValue(false),
+		})
+	}
+
+	roles, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: roleAttrTypes()}, result)
+	state.Roles = roles
+
+	return diags
+}
@@ -0,0 +1,53 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package projectrolesdatasource
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/terraform-provider-ec/ec/internal/gen/serverless"
+)
+
+func Test_modelToState(t *testing.T) {
+	ctx := context.Background()
+	roleType := types.ObjectType{AttrTypes: roleAttrTypes()}
+
+	state := modelV0{ProjectID: types.StringValue("id"), ProjectType: types.StringValue("elasticsearch")}
+	diags := modelToState(ctx, []string{"admin"}, []serverless.ProjectRoleDetails{
+		{Name: "custom", Description: "A custom role"},
+	}, &state)
+	require.False(t, diags.HasError())
+
+	require.Equal(t, types.ListValueMust(roleType, []attr.Value{
+		types.ObjectValueMust(roleAttrTypes(), map[string]attr.Value{
+			"name":        types.StringValue("admin"),
+			"description": types.StringNull(),
+			"built_in":    types.BoolValue(true),
+		}),
+		types.ObjectValueMust(roleAttrTypes(), map[string]attr.Value{
+			"name":        types.StringValue("custom"),
+			"description": types.StringValue("A custom role"),
+			"built_in":    types.BoolValue(false),
+		}),
+	}), state.Roles)
+}
@@ -0,0 +1,92 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package projectrolesdatasource
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var projectTypes = []string{"elasticsearch", "observability", "security"}
+
+func (d *DataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Use this data source to list the built-in and custom roles of a serverless project. The role names can be used as `application_roles` of the `ec_organization` project role assignments.",
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				Description: "ID of the project to list the roles for.",
+				Required:    true,
+			},
+			"project_type": schema.StringAttribute{
+				Description: "Type of the project identified by `project_id`. One of `elasticsearch`, `observability` or `security`.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(projectTypes...),
+				},
+			},
+
+			// computed fields
+			"roles": rolesSchema(),
+		},
+	}
+}
+
+func rolesSchema() schema.Attribute {
+	return schema.ListNestedAttribute{
+		Description: "The roles available in the project. Built-in roles are listed first.",
+		Computed:    true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"name": schema.StringAttribute{
+					Description: "The name of the role.",
+					Computed:    true,
+				},
+				"description": schema.StringAttribute{
+					Description: "The description of the role. Only set for custom roles.",
+					Computed:    true,
+				},
+				"built_in": schema.BoolAttribute{
+					Description: "Whether the role is predefined for the project type, as opposed to a custom role created in the project.",
+					Computed:    true,
+				},
+			},
+		},
+	}
+}
+
+func roleAttrTypes() map[string]attr.Type {
+	return rolesSchema().GetType().(types.ListType).ElemType.(types.ObjectType).AttrTypes
+}
+
+type modelV0 struct {
+	ProjectID   types.String `tfsdk:"project_id"`
+	ProjectType types.String `tfsdk:"project_type"`
+	Roles       types.List   `tfsdk:"roles"` //< roleModelV0
+}
+
+type roleModelV0 struct {
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	BuiltIn     types.Bool   `tfsdk:"built_in"`
+}
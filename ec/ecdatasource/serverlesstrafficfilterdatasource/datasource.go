@@ -0,0 +1,268 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package serverlesstrafficfilterdatasource
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/elastic/terraform-provider-ec/ec/internal"
+	"github.com/elastic/terraform-provider-ec/ec/internal/gen/serverless"
+)
+
+type DataSource struct {
+	client serverless.ClientWithResponsesInterface
+}
+
+var _ datasource.DataSource = &DataSource{}
+var _ datasource.DataSourceWithConfigure = &DataSource{}
+
+func (d *DataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Use this data source to filter for existing serverless traffic filters. All the provided filters must match for a traffic filter to be selected.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The id of the traffic filter to select.",
+				Optional:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The exact name of the traffic filter to select.",
+				Optional:    true,
+			},
+			"region": schema.StringAttribute{
+				Description: "Region where the traffic filter is.",
+				Optional:    true,
+			},
+
+			// computed fields
+			"traffic_filters": trafficFiltersSchema(),
+		},
+	}
+}
+
+func trafficFiltersSchema() schema.Attribute {
+	return schema.ListNestedAttribute{
+		Description: "The traffic filters matching the provided filters.",
+		Computed:    true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"id": schema.StringAttribute{
+					Description: "The ID of the traffic filter.",
+					Computed:    true,
+				},
+				"name": schema.StringAttribute{
+					Description: "The name of the traffic filter.",
+					Computed:    true,
+				},
+				"description": schema.StringAttribute{
+					Description: "The description of the traffic filter.",
+					Computed:    true,
+				},
+				"region": schema.StringAttribute{
+					Description: "The traffic filter can be attached only to projects in the specific region.",
+					Computed:    true,
+				},
+				"type": schema.StringAttribute{
+					Description: "The type of the traffic filter.",
+					Computed:    true,
+				},
+				"include_by_default": schema.BoolAttribute{
+					Description: "Should the traffic filter be automatically included in new projects.",
+					Computed:    true,
+				},
+				"rules": rulesSchema(),
+			},
+		},
+	}
+}
+
+func rulesSchema() schema.Attribute {
+	return schema.ListNestedAttribute{
+		Description: "The rules of the traffic filter.",
+		Computed:    true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"source": schema.StringAttribute{
+					Description: "Allowed traffic filter source: IP address, CIDR mask, or VPC endpoint ID.",
+					Computed:    true,
+				},
+				"description": schema.StringAttribute{
+					Description: "The description of the rule.",
+					Computed:    true,
+				},
+				"azure_endpoint_name": schema.StringAttribute{
+					Description: "Name of the Azure Private Endpoint.",
+					Computed:    true,
+				},
+				"azure_endpoint_guid": schema.StringAttribute{
+					Description: "Resource GUID of the Azure Private Endpoint.",
+					Computed:    true,
+				},
+			},
+		},
+	}
+}
+
+func (d DataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	// Prevent panic if the provider has not been configured.
+	if d.client == nil {
+		response.Diagnostics.AddError(
+			"Unconfigured API Client",
+			"Expected configured API client. Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	var newState modelV0
+	response.Diagnostics.Append(request.Config.Get(ctx, &newState)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	filters, err := listTrafficFilters(ctx, d.client, newState)
+	if err != nil {
+		response.Diagnostics.AddError(
+			"Failed retrieving traffic filters",
+			fmt.Sprintf("Failed retrieving traffic filters: %s", err),
+		)
+		return
+	}
+
+	response.Diagnostics.Append(modelToState(ctx, filters, &newState)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Finally, set the state
+	response.Diagnostics.Append(response.State.Set(ctx, newState)...)
+}
+
+func (d *DataSource) Metadata(ctx context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_serverless_traffic_filter"
+}
+
+func (d *DataSource) Configure(ctx context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
+	clients, diags := internal.ConvertProviderData(request.ProviderData)
+	response.Diagnostics.Append(diags...)
+	d.client = clients.Serverless
+}
+
+type modelV0 struct {
+	Id             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	Region         types.String `tfsdk:"region"`
+	TrafficFilters types.List   `tfsdk:"traffic_filters"` //< trafficFilterModelV0
+}
+
+type trafficFilterModelV0 struct {
+	Id               types.String  `tfsdk:"id"`
+	Name             types.String  `tfsdk:"name"`
+	Description      types.String  `tfsdk:"description"`
+	Region           types.String  `tfsdk:"region"`
+	Type             types.String  `tfsdk:"type"`
+	IncludeByDefault types.Bool    `tfsdk:"include_by_default"`
+	Rules            []ruleModelV0 `tfsdk:"rules"`
+}
+
+type ruleModelV0 struct {
+	Source            types.String `tfsdk:"source"`
+	Description       types.String `tfsdk:"description"`
+	AzureEndpointName types.String `tfsdk:"azure_endpoint_name"`
+	AzureEndpointGuid types.String `tfsdk:"azure_endpoint_guid"`
+}
+
+// listTrafficFilters fetches a single traffic filter when an id is given, and lists the
+// traffic filters of the configured region (if any) otherwise.
+func listTrafficFilters(ctx context.Context, client serverless.ClientWithResponsesInterface, model modelV0) ([]serverless.TrafficFilterInfo, error) {
+	if id := model.Id.ValueString(); id != "" {
+		resp, err := client.GetTrafficFilterWithResponse(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if resp.StatusCode() == http.StatusNotFound {
+			return nil, nil
+		}
+		if resp.JSON200 == nil {
+			return nil, fmt.Errorf("the API request failed with: %d %s\n%s", resp.StatusCode(), resp.Status(), resp.Body)
+		}
+		return []serverless.TrafficFilterInfo{*resp.JSON200}, nil
+	}
+
+	resp, err := client.ListTrafficFiltersWithResponse(ctx, &serverless.ListTrafficFiltersParams{
+		Region: model.Region.ValueStringPointer(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, fmt.Errorf("the API request failed with: %d %s\n%s", resp.StatusCode(), resp.Status(), resp.Body)
+	}
+	return resp.JSON200.Items, nil
+}
+
+func modelToState(ctx context.Context, filters []serverless.TrafficFilterInfo, state *modelV0) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var result = make([]trafficFilterModelV0, 0, len(filters))
+
+	for _, filter := range filters {
+		if !matches(state.Id, filter.Id) || !matches(state.Name, filter.Name) || !matches(state.Region, filter.Region) {
+			continue
+		}
+
+		m := trafficFilterModelV0{
+			Id:               types.StringValue(filter.Id),
+			Name:             types.StringValue(filter.Name),
+			Description:      types.StringPointerValue(filter.Description),
+			Region:           types.StringValue(filter.Region),
+			Type:             types.StringValue(string(filter.Type)),
+			IncludeByDefault: types.BoolValue(filter.IncludeByDefault),
+			Rules:            make([]ruleModelV0, 0, len(filter.Rules)),
+		}
+
+		for _, rule := range filter.Rules {
+			m.Rules = append(m.Rules, ruleModelV0{
+				Source:            types.StringPointerValue(rule.Source),
+				Description:       types.StringPointerValue(rule.Description),
+				AzureEndpointName: types.StringPointerValue(rule.AzureEndpointName),
+				AzureEndpointGuid: types.StringPointerValue(rule.AzureEndpointGuid),
+			})
+		}
+
+		result = append(result, m)
+	}
+
+	state.TrafficFilters, diags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: trafficFilterAttrTypes()}, result)
+	return diags
+}
+
+// matches returns true when the filter is not set or equals the given value.
+func matches(filter types.String, value string) bool {
+	return filter.IsNull() || filter.IsUnknown() || filter.ValueString() == value
+}
+
+func trafficFilterAttrTypes() map[string]attr.Type {
+	return trafficFiltersSchema().GetType().(types.ListType).ElemType.(types.ObjectType).AttrTypes
+}
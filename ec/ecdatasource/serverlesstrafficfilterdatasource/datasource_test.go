@@ -0,0 +1,142 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package serverlesstrafficfilterdatasource
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/elastic/terraform-provider-ec/ec/internal/gen/serverless"
+	"github.com/elastic/terraform-provider-ec/ec/internal/gen/serverless/mocks"
+)
+
+func Test_listTrafficFilters(t *testing.T) {
+	ctx := context.Background()
+	filter := serverless.TrafficFilterInfo{Id: "id", Name: "name", Region: "aws-us-east-1", Type: "ip"}
+
+	t.Run("gets the traffic filter when an id is given", func(t *testing.T) {
+		client := mocks.NewMockClientWithResponsesInterface(gomock.NewController(t))
+		client.EXPECT().GetTrafficFilterWithResponse(ctx, "id").Return(&serverless.GetTrafficFilterResponse{
+			HTTPResponse: &http.Response{StatusCode: 200},
+			JSON200:      &filter,
+		}, nil)
+
+		filters, err := listTrafficFilters(ctx, client, modelV0{Id: types.StringValue("id")})
+		require.NoError(t, err)
+		require.Equal(t, []serverless.TrafficFilterInfo{filter}, filters)
+	})
+
+	t.Run("returns nothing when the traffic filter does not exist", func(t *testing.T) {
+		client := mocks.NewMockClientWithResponsesInterface(gomock.NewController(t))
+		client.EXPECT().GetTrafficFilterWithResponse(ctx, "id").Return(&serverless.GetTrafficFilterResponse{
+			HTTPResponse: &http.Response{StatusCode: 404},
+		}, nil)
+
+		filters, err := listTrafficFilters(ctx, client, modelV0{Id: types.StringValue("id")})
+		require.NoError(t, err)
+		require.Empty(t, filters)
+	})
+
+	t.Run("lists the traffic filters of the region", func(t *testing.T) {
+		client := mocks.NewMockClientWithResponsesInterface(gomock.NewController(t))
+		client.EXPECT().
+			ListTrafficFiltersWithResponse(ctx, &serverless.ListTrafficFiltersParams{Region: new("aws-us-east-1")}).
+			Return(&serverless.ListTrafficFiltersResponse{
+				HTTPResponse: &http.Response{StatusCode: 200},
+				JSON200:      &serverless.TrafficFilterList{Items: []serverless.TrafficFilterInfo{filter}},
+			}, nil)
+
+		filters, err := listTrafficFilters(ctx, client, modelV0{Id: types.StringNull(), Region: types.StringValue("aws-us-east-1")})
+		require.NoError(t, err)
+		require.Equal(t, []serverless.TrafficFilterInfo{filter}, filters)
+	})
+
+	t.Run("returns an error when the list fails", func(t *testing.T) {
+		client := mocks.NewMockClientWithResponsesInterface(gomock.NewController(t))
+		client.EXPECT().
+			ListTrafficFiltersWithResponse(ctx, &serverless.ListTrafficFiltersParams{}).
+			Return(&serverless.ListTrafficFiltersResponse{
+				HTTPResponse: &http.Response{StatusCode: 500, Status: "500 Internal Server Error"},
+				Body:         []byte("boom"),
+			}, nil)
+
+		_, err := listTrafficFilters(ctx, client, modelV0{Id: types.StringNull(), Region: types.StringNull()})
+		require.EqualError(t, err, "the API request failed with: 500 500 Internal Server Error\nboom")
+	})
+}
+
+func Test_modelToState(t *testing.T) {
+	ctx := context.Background()
+	filters := []serverless.TrafficFilterInfo{
+		{
+			Id:               "first",
+			Name:             "office",
+			Description:      new("Office network"),
+			Region:           "aws-us-east-1",
+			Type:             "ip",
+			IncludeByDefault: true,
+			Rules:            []serverless.TrafficFilterRule{{Source: new("192.168.0.0/24")}},
+		},
+		{
+			Id:     "second",
+			Name:   "office",
+			Region: "aws-eu-west-1",
+			Type:   "ip",
+		},
+		{
+			Id:     "third",
+			Name:   "vpn",
+			Region: "aws-us-east-1",
+			Type:   "vpce",
+		},
+	}
+
+	state := modelV0{
+		Id:     types.StringNull(),
+		Name:   types.StringValue("office"),
+		Region: types.StringValue("aws-us-east-1"),
+	}
+	diags := modelToState(ctx, filters, &state)
+	require.False(t, diags.HasError())
+
+	var got []trafficFilterModelV0
+	require.False(t, state.TrafficFilters.ElementsAs(ctx, &got, false).HasError())
+	require.Equal(t, []trafficFilterModelV0{
+		{
+			Id:               types.StringValue("first"),
+			Name:             types.StringValue("office"),
+			Description:      types.StringValue("Office network"),
+			Region:           types.StringValue("aws-us-east-1"),
+			Type:             types.StringValue("ip"),
+			IncludeByDefault: types.BoolValue(true),
+			Rules: []ruleModelV0{
+				{
+					Source:            types.StringValue("192.168.0.0/24"),
+					Description:       types.StringNull(),
+					AzureEndpointName: types.StringNull(),
+					AzureEndpointGuid: types.StringNull(),
+				},
+			},
+		},
+	}, got)
+}
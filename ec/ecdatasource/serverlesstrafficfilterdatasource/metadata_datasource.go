@@ -0,0 +1,213 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package serverlesstrafficfilterdatasource
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/elastic/terraform-provider-ec/ec/internal"
+	"github.com/elastic/terraform-provider-ec/ec/internal/gen/serverless"
+)
+
+type MetadataDataSource struct {
+	client serverless.ClientWithResponsesInterface
+}
+
+var _ datasource.DataSource = &MetadataDataSource{}
+var _ datasource.DataSourceWithConfigure = &MetadataDataSource{}
+
+func (d *MetadataDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Use this data source to retrieve the private connectivity details needed to set up a private endpoint (AWS PrivateLink, Azure Private Link or GCP Private Service Connect) for serverless projects.",
+		Attributes: map[string]schema.Attribute{
+			"region": schema.StringAttribute{
+				Description: "Only return the details of the given region (e.g. `aws-eu-west-1`).",
+				Optional:    true,
+			},
+			"csp": schema.StringAttribute{
+				Description: "Only return the details of the regions hosted on the given cloud service provider (`aws`, `azure` or `gcp`).",
+				Optional:    true,
+			},
+
+			// computed fields
+			"regions": regionsSchema(),
+		},
+	}
+}
+
+func regionsSchema() schema.Attribute {
+	return schema.ListNestedAttribute{
+		Description: "The private connectivity details of each region.",
+		Computed:    true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"region": schema.StringAttribute{
+					Description: "The region.",
+					Computed:    true,
+				},
+				"csp": schema.StringAttribute{
+					Description: "The cloud service provider hosting the region.",
+					Computed:    true,
+				},
+				"private_service_name": schema.StringAttribute{
+					Description: "The service to connect the private endpoint to. For AWS the VPC endpoint service name, for Azure the Private Link Service alias and for GCP the Service Attachment URI.",
+					Computed:    true,
+				},
+				"private_hosted_zone_domain_name": schema.StringAttribute{
+					Description: "The domain name to use when configuring a private hosted zone for the private endpoint.",
+					Computed:    true,
+				},
+				"availability_zones": schema.ListNestedAttribute{
+					Description: "The availability zones hosting the private endpoint service.",
+					Computed:    true,
+					NestedObject: schema.NestedAttributeObject{
+						Attributes: map[string]schema.Attribute{
+							"id": schema.StringAttribute{
+								Description: "The ID of the availability zone.",
+								Computed:    true,
+							},
+							"name": schema.StringAttribute{
+								Description: "The name of the availability zone.",
+								Computed:    true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d MetadataDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	// Prevent panic if the provider has not been configured.
+	if d.client == nil {
+		response.Diagnostics.AddError(
+			"Unconfigured API Client",
+			"Expected configured API client. Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	var newState metadataModelV0
+	response.Diagnostics.Append(request.Config.Get(ctx, &newState)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	resp, err := d.client.GetTrafficFilterMetadataWithResponse(ctx, &serverless.GetTrafficFilterMetadataParams{
+		Region: newState.Region.ValueStringPointer(),
+		Csp:    newState.Csp.ValueStringPointer(),
+	})
+	if err != nil {
+		response.Diagnostics.AddError("Failed retrieving traffic filter metadata", err.Error())
+		return
+	}
+	if resp.JSON200 == nil {
+		response.Diagnostics.AddError(
+			"Failed retrieving traffic filter metadata",
+			fmt.Sprintf("The API request failed with: %d %s\n%s", resp.StatusCode(), resp.Status(), resp.Body),
+		)
+		return
+	}
+
+	response.Diagnostics.Append(metadataToState(ctx, *resp.JSON200, &newState)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Finally, set the state
+	response.Diagnostics.Append(response.State.Set(ctx, newState)...)
+}
+
+func (d *MetadataDataSource) Metadata(ctx context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_serverless_traffic_filter_metadata"
+}
+
+func (d *MetadataDataSource) Configure(ctx context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
+	clients, diags := internal.ConvertProviderData(request.ProviderData)
+	response.Diagnostics.Append(diags...)
+	d.client = clients.Serverless
+}
+
+type metadataModelV0 struct {
+	Region  types.String `tfsdk:"region"`
+	Csp     types.String `tfsdk:"csp"`
+	Regions types.List   `tfsdk:"regions"` //< regionMetadataModelV0
+}
+
+type regionMetadataModelV0 struct {
+	Region                      types.String              `tfsdk:"region"`
+	Csp                         types.String              `tfsdk:"csp"`
+	PrivateServiceName          types.String              `tfsdk:"private_service_name"`
+	PrivateHostedZoneDomainName types.String              `tfsdk:"private_hosted_zone_domain_name"`
+	AvailabilityZones           []availabilityZoneModelV0 `tfsdk:"availability_zones"`
+}
+
+type availabilityZoneModelV0 struct {
+	Id   types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+}
+
+func metadataToState(ctx context.Context, metadata serverless.TrafficFilterMetadata, state *metadataModelV0) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var regions []serverless.TrafficFilterRegionMetadata
+	if metadata.Regions != nil {
+		regions = *metadata.Regions
+	}
+
+	var result = make([]regionMetadataModelV0, 0, len(regions))
+	for _, region := range regions {
+		// vpc_service_name is deprecated in favour of private_service_name, but older
+		// regions may only report the former.
+		serviceName := region.VpcServiceName
+		if region.PrivateServiceName != nil {
+			serviceName = *region.PrivateServiceName
+		}
+
+		m := regionMetadataModelV0{
+			Region:                      types.StringValue(region.Region),
+			Csp:                         types.StringPointerValue(region.Csp),
+			PrivateServiceName:          types.StringValue(serviceName),
+			PrivateHostedZoneDomainName: types.StringValue(region.PrivateHostedZoneDomainName),
+			AvailabilityZones:           make([]availabilityZoneModelV0, 0, len(region.AvailabilityZones)),
+		}
+		for _, zone := range region.AvailabilityZones {
+			m.AvailabilityZones = append(m.AvailabilityZones, availabilityZoneModelV0{
+				Id:   types.StringValue(zone.Id),
+				Name: types.StringValue(zone.Name),
+			})
+		}
+
+		result = append(result, m)
+	}
+
+	state.Regions, diags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: regionAttrTypes()}, result)
+	return diags
+}
+
+func regionAttrTypes() map[string]attr.Type {
+	return regionsSchema().GetType().(types.ListType).ElemType.(types.ObjectType).AttrTypes
+}
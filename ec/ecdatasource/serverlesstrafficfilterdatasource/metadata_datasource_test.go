@@ -0,0 +1,82 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package serverlesstrafficfilterdatasource
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/terraform-provider-ec/ec/internal/gen/serverless"
+)
+
+func Test_metadataToState(t *testing.T) {
+	ctx := context.Background()
+	metadata := serverless.TrafficFilterMetadata{
+		Regions: &[]serverless.TrafficFilterRegionMetadata{
+			{
+				Region:                      "aws-us-east-1",
+				Csp:                         new("aws"),
+				PrivateServiceName:          new("com.amazonaws.vpce.us-east-1.vpce-svc-0e42e1e06ed010238"),
+				VpcServiceName:              "com.amazonaws.vpce.us-east-1.vpce-svc-0e42e1e06ed010238",
+				PrivateHostedZoneDomainName: "private.us-east-1.aws.elastic.cloud",
+				AvailabilityZones:           []serverless.TrafficFilterAvailabilityZone{{Id: "use1-az2", Name: "us-east-1a"}},
+			},
+			{
+				Region:                      "aws-eu-west-1",
+				VpcServiceName:              "com.amazonaws.vpce.eu-west-1.vpce-svc-01f2afe87944eb12b",
+				PrivateHostedZoneDomainName: "private.eu-west-1.aws.elastic.cloud",
+			},
+		},
+	}
+
+	var state metadataModelV0
+	diags := metadataToState(ctx, metadata, &state)
+	require.False(t, diags.HasError())
+
+	var got []regionMetadataModelV0
+	require.False(t, state.Regions.ElementsAs(ctx, &got, false).HasError())
+	require.Equal(t, []regionMetadataModelV0{
+		{
+			Region:                      types.StringValue("aws-us-east-1"),
+			Csp:                         types.StringValue("aws"),
+			PrivateServiceName:          types.StringValue("com.amazonaws.vpce.us-east-1.vpce-svc-0e42e1e06ed010238"),
+			PrivateHostedZoneDomainName: types.StringValue("private.us-east-1.aws.elastic.cloud"),
+			AvailabilityZones: []availabilityZoneModelV0{
+				{Id: types.StringValue("use1-az2"), Name: types.StringValue("us-east-1a")},
+			},
+		},
+		{
+			Region:                      types.StringValue("aws-eu-west-1"),
+			Csp:                         types.StringNull(),
+			PrivateServiceName:          types.StringValue("com.amazonaws.vpce.eu-west-1.vpce-svc-01f2afe87944eb12b"),
+			PrivateHostedZoneDomainName: types.StringValue("private.eu-west-1.aws.elastic.cloud"),
+			AvailabilityZones:           []availabilityZoneModelV0{},
+		},
+	}, got)
+
+	t.Run("empty metadata results in an empty list", func(t *testing.T) {
+		var state metadataModelV0
+		diags := metadataToState(ctx, serverless.TrafficFilterMetadata{}, &state)
+		require.False(t, diags.HasError())
+		require.Empty(t, state.Regions.Elements())
+		require.False(t, state.Regions.IsNull())
+	})
+}
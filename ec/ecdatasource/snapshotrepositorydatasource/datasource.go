@@ -0,0 +1,214 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package snapshotrepositorydatasource
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/elastic/cloud-sdk-go/pkg/api"
+	"github.com/elastic/cloud-sdk-go/pkg/api/platformapi/snaprepoapi"
+	"github.com/elastic/cloud-sdk-go/pkg/models"
+
+	"github.com/elastic/terraform-provider-ec/ec/internal"
+)
+
+// This data source is only usable for ECE installations. Thus, we can default to ece-region.
+const region = "ece-region"
+
+const eceOnlyNote = `
+
+  ~> **This data source can only be used with Elastic Cloud Enterprise**`
+
+type DataSource struct {
+	client *api.API
+}
+
+var _ datasource.DataSource = &DataSource{}
+var _ datasource.DataSourceWithConfigure = &DataSource{}
+
+func (d *DataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Use this data source to retrieve the Elastic Cloud Enterprise snapshot repositories." + eceOnlyNote,
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				Description: "Filter the result by repository type (e.g. `s3`, `gcs` or `azure`).",
+				Optional:    true,
+			},
+
+			// computed fields
+			"names": schema.ListAttribute{
+				Description: "The names of the snapshot repositories matching the filters.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"repositories": repositoriesSchema(),
+		},
+	}
+}
+
+func repositoriesSchema() schema.Attribute {
+	return schema.ListNestedAttribute{
+		Description: "The snapshot repositories matching the filters.",
+		Computed:    true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: repositoryAttributes(),
+		},
+	}
+}
+
+func repositoryAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"name": schema.StringAttribute{
+			Description: "The name of the snapshot repository.",
+			Computed:    true,
+		},
+		"type": schema.StringAttribute{
+			Description: "The repository type.",
+			Computed:    true,
+		},
+		"settings": schema.StringAttribute{
+			Description: "The repository settings as a JSON object.",
+			Computed:    true,
+			Sensitive:   true,
+		},
+	}
+}
+
+func (d DataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	// Prevent panic if the provider has not been configured.
+	if d.client == nil {
+		response.Diagnostics.AddError(
+			"Unconfigured API Client",
+			"Expected configured API client. Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	var newState modelV0
+	response.Diagnostics.Append(request.Config.Get(ctx, &newState)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := snaprepoapi.List(snaprepoapi.ListParams{
+		API:    d.client,
+		Region: region,
+	})
+	if err != nil {
+		response.Diagnostics.AddError(
+			"Failed retrieving snapshot repositories",
+			fmt.Sprintf("Failed retrieving snapshot repositories: %s", err),
+		)
+		return
+	}
+
+	response.Diagnostics.Append(modelToState(ctx, res, &newState)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Finally, set the state
+	response.Diagnostics.Append(response.State.Set(ctx, newState)...)
+}
+
+func (d *DataSource) Metadata(ctx context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_snapshot_repositories"
+}
+
+func (d *DataSource) Configure(ctx context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
+	clients, diags := internal.ConvertProviderData(request.ProviderData)
+	response.Diagnostics.Append(diags...)
+	d.client = clients.Stateful
+}
+
+type modelV0 struct {
+	Type         types.String `tfsdk:"type"`
+	Names        []string     `tfsdk:"names"`
+	Repositories types.List   `tfsdk:"repositories"` //< repositoryModelV0
+}
+
+type repositoryModelV0 struct {
+	Name     types.String `tfsdk:"name"`
+	Type     types.String `tfsdk:"type"`
+	Settings types.String `tfsdk:"settings"`
+}
+
+func modelToState(ctx context.Context, res *models.RepositoryConfigs, state *modelV0) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	names := make([]string, 0, len(res.Configs))
+	repositories := make([]repositoryModelV0, 0, len(res.Configs))
+	for _, config := range res.Configs {
+		if config == nil {
+			continue
+		}
+
+		repository, err := repositoryToModel(config)
+		if err != nil {
+			diags.AddError("Failed reading snapshot repository", err.Error())
+			return diags
+		}
+
+		if !state.Type.IsNull() && repository.Type.ValueString() != state.Type.ValueString() {
+			continue
+		}
+
+		names = append(names, repository.Name.ValueString())
+		repositories = append(repositories, repository)
+	}
+
+	state.Names = names
+	state.Repositories, diags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: repositoryAttrTypes()}, repositories)
+	return diags
+}
+
+func repositoryToModel(config *models.RepositoryConfig) (repositoryModelV0, error) {
+	m := repositoryModelV0{
+		Name:     types.StringPointerValue(config.RepositoryName),
+		Type:     types.StringNull(),
+		Settings: types.StringNull(),
+	}
+
+	repositoryConfig, _ := config.Config.(map[string]any)
+	if repositoryType, ok := repositoryConfig["type"].(string); ok {
+		m.Type = types.StringValue(repositoryType)
+	}
+
+	if settings, ok := repositoryConfig["settings"]; ok && settings != nil {
+		jsonSettings, err := json.Marshal(settings)
+		if err != nil {
+			return m, fmt.Errorf("unable to marshal the settings of %s: %w", m.Name.ValueString(), err)
+		}
+		m.Settings = types.StringValue(string(jsonSettings))
+	}
+
+	return m, nil
+}
+
+func repositoryAttrTypes() map[string]attr.Type {
+	return repositoriesSchema().GetType().(types.ListType).ElemType.(types.ObjectType).AttrTypes
+}
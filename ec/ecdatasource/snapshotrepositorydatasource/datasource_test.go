@@ -0,0 +1,104 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package snapshotrepositorydatasource
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/cloud-sdk-go/pkg/models"
+)
+
+func Test_modelToState(t *testing.T) {
+	res := &models.RepositoryConfigs{
+		Configs: []*models.RepositoryConfig{
+			{
+				RepositoryName: new("found"),
+				Config: map[string]any{
+					"type":     "s3",
+					"settings": map[string]any{"bucket": "my-bucket", "region": "us-east-1"},
+				},
+			},
+			{
+				RepositoryName: new("backups"),
+				Config: map[string]any{
+					"type":     "gcs",
+					"settings": map[string]any{"bucket": "my-backups"},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		state     modelV0
+		wantNames []string
+		want      []repositoryModelV0
+	}{
+		{
+			name:      "returns all the repositories without filters",
+			wantNames: []string{"found", "backups"},
+			want: []repositoryModelV0{
+				{
+					Name:     types.StringValue("found"),
+					Type:     types.StringValue("s3"),
+					Settings: types.StringValue(`{"bucket":"my-bucket","region":"us-east-1"}`),
+				},
+				{
+					Name:     types.StringValue("backups"),
+					Type:     types.StringValue("gcs"),
+					Settings: types.StringValue(`{"bucket":"my-backups"}`),
+				},
+			},
+		},
+		{
+			name:      "filters by type",
+			state:     modelV0{Type: types.StringValue("gcs")},
+			wantNames: []string{"backups"},
+			want: []repositoryModelV0{
+				{
+					Name:     types.StringValue("backups"),
+					Type:     types.StringValue("gcs"),
+					Settings: types.StringValue(`{"bucket":"my-backups"}`),
+				},
+			},
+		},
+		{
+			name:      "returns nothing when no repository matches",
+			state:     modelV0{Type: types.StringValue("azure")},
+			wantNames: []string{},
+			want:      []repositoryModelV0{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := tt.state
+			diags := modelToState(context.Background(), res, &state)
+			require.False(t, diags.HasError(), diags)
+			assert.Equal(t, tt.wantNames, state.Names)
+
+			got := []repositoryModelV0{}
+			require.False(t, state.Repositories.ElementsAs(context.Background(), &got, false).HasError())
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,110 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package snapshotrepositorydatasource
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+
+	"github.com/elastic/cloud-sdk-go/pkg/api"
+	"github.com/elastic/cloud-sdk-go/pkg/api/apierror"
+	"github.com/elastic/cloud-sdk-go/pkg/api/platformapi/snaprepoapi"
+
+	"github.com/elastic/terraform-provider-ec/ec/internal"
+)
+
+type RepositoryDataSource struct {
+	client *api.API
+}
+
+var _ datasource.DataSource = &RepositoryDataSource{}
+var _ datasource.DataSourceWithConfigure = &RepositoryDataSource{}
+
+func (d *RepositoryDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := repositoryAttributes()
+	attributes["name"] = schema.StringAttribute{
+		Description: "The name of the snapshot repository.",
+		Required:    true,
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Use this data source to retrieve an existing Elastic Cloud Enterprise snapshot repository." + eceOnlyNote,
+		Attributes:  attributes,
+	}
+}
+
+func (d RepositoryDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	// Prevent panic if the provider has not been configured.
+	if d.client == nil {
+		response.Diagnostics.AddError(
+			"Unconfigured API Client",
+			"Expected configured API client. Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	var newState repositoryModelV0
+	response.Diagnostics.Append(request.Config.Get(ctx, &newState)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := snaprepoapi.Get(snaprepoapi.GetParams{
+		API:    d.client,
+		Region: region,
+		Name:   newState.Name.ValueString(),
+	})
+	if err != nil {
+		if apierror.IsRuntimeStatusCode(err, 404) {
+			response.Diagnostics.AddError(
+				"Snapshot repository not found",
+				fmt.Sprintf("No snapshot repository named %s was found.", newState.Name.ValueString()),
+			)
+			return
+		}
+
+		response.Diagnostics.AddError(
+			"Failed retrieving snapshot repository",
+			fmt.Sprintf("Failed retrieving snapshot repository %s: %s", newState.Name.ValueString(), err),
+		)
+		return
+	}
+
+	repository, err := repositoryToModel(res)
+	if err != nil {
+		response.Diagnostics.AddError("Failed reading snapshot repository", err.Error())
+		return
+	}
+
+	// Finally, set the state
+	response.Diagnostics.Append(response.State.Set(ctx, repository)...)
+}
+
+func (d *RepositoryDataSource) Metadata(ctx context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_snapshot_repository"
+}
+
+func (d *RepositoryDataSource) Configure(ctx context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
+	clients, diags := internal.ConvertProviderData(request.ProviderData)
+	response.Diagnostics.Append(diags...)
+	d.client = clients.Stateful
+}
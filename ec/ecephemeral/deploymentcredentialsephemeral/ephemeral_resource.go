@@ -0,0 +1,123 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package deploymentcredentialsephemeral
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/elastic/cloud-sdk-go/pkg/api"
+	"github.com/elastic/cloud-sdk-go/pkg/api/deploymentapi/depresourceapi"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/elastic/terraform-provider-ec/ec/internal"
+)
+
+var _ ephemeral.EphemeralResource = &EphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &EphemeralResource{}
+
+type EphemeralResource struct {
+	client *api.API
+}
+
+func (r *EphemeralResource) Configure(ctx context.Context, request ephemeral.ConfigureRequest, response *ephemeral.ConfigureResponse) {
+	clients, diags := internal.ConvertProviderData(request.ProviderData)
+	response.Diagnostics.Append(diags...)
+	r.client = clients.Stateful
+}
+
+func (r *EphemeralResource) Metadata(ctx context.Context, request ephemeral.MetadataRequest, response *ephemeral.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_deployment_credentials"
+}
+
+func (r *EphemeralResource) Schema(ctx context.Context, request ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		MarkdownDescription: `Resets the password of the ` + "`elastic`" + ` user of a deployment and returns the new credentials. The credentials are never persisted in the Terraform state or plan. Note that a new password is generated each time Terraform opens this ephemeral resource, which invalidates the previous one.`,
+		Attributes: map[string]schema.Attribute{
+			"deployment_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the deployment to reset the credentials for.",
+				Required:            true,
+			},
+			"ref_id": schema.StringAttribute{
+				MarkdownDescription: "Ref ID of the Elasticsearch resource of the deployment. Discovered automatically when not set.",
+				Optional:            true,
+			},
+			"username": schema.StringAttribute{
+				MarkdownDescription: "Username of the `elastic` user.",
+				Computed:            true,
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "Newly generated password of the `elastic` user.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+		},
+	}
+}
+
+type modelV0 struct {
+	DeploymentID types.String `tfsdk:"deployment_id"`
+	RefID        types.String `tfsdk:"ref_id"`
+	Username     types.String `tfsdk:"username"`
+	Password     types.String `tfsdk:"password"`
+}
+
+func (r *EphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		response.Diagnostics.AddError(
+			"Unconfigured API Client",
+			"Expected configured API client. Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	var model modelV0
+	response.Diagnostics.Append(request.Config.Get(ctx, &model)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := resetCredentials(r.client, &model); err != nil {
+		response.Diagnostics.AddError(
+			"Failed to reset deployment credentials",
+			fmt.Sprintf("Failed to reset the elasticsearch password of deployment %s: %s", model.DeploymentID.ValueString(), err),
+		)
+		return
+	}
+
+	response.Diagnostics.Append(response.Result.Set(ctx, model)...)
+}
+
+func resetCredentials(client *api.API, model *modelV0) error {
+	resp, err := depresourceapi.ResetElasticsearchPassword(depresourceapi.ResetElasticsearchPasswordParams{
+		API:   client,
+		ID:    model.DeploymentID.ValueString(),
+		RefID: model.RefID.ValueString(),
+	})
+	if err != nil {
+		return err
+	}
+
+	model.Username = types.StringPointerValue(resp.Username)
+	model.Password = types.StringPointerValue(resp.Password)
+	return nil
+}
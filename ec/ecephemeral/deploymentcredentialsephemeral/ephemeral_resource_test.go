@@ -0,0 +1,92 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package deploymentcredentialsephemeral
+
+import (
+	"testing"
+
+	"github.com/elastic/cloud-sdk-go/pkg/api"
+	"github.com/elastic/cloud-sdk-go/pkg/api/mock"
+	"github.com/elastic/cloud-sdk-go/pkg/models"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_resetCredentials(t *testing.T) {
+	resetResponse := func() mock.Response {
+		return mock.New200Response(mock.NewStructBody(models.ElasticsearchElasticUserPasswordResetResponse{
+			Username: new("elastic"),
+			Password: new("new-password"),
+		}))
+	}
+
+	t.Run("resets the password of the given resource", func(t *testing.T) {
+		model := modelV0{
+			DeploymentID: types.StringValue(mock.ValidClusterID),
+			RefID:        types.StringValue("main-elasticsearch"),
+		}
+
+		err := resetCredentials(api.NewMock(resetResponse()), &model)
+		require.NoError(t, err)
+		require.Equal(t, modelV0{
+			DeploymentID: types.StringValue(mock.ValidClusterID),
+			RefID:        types.StringValue("main-elasticsearch"),
+			Username:     types.StringValue("elastic"),
+			Password:     types.StringValue("new-password"),
+		}, model)
+	})
+
+	t.Run("discovers the elasticsearch resource when no ref_id is set", func(t *testing.T) {
+		model := modelV0{
+			DeploymentID: types.StringValue(mock.ValidClusterID),
+			RefID:        types.StringNull(),
+		}
+
+		client := api.NewMock(
+			mock.New200Response(mock.NewStructBody(models.DeploymentGetResponse{
+				Healthy: new(true),
+				ID:      new(mock.ValidClusterID),
+				Resources: &models.DeploymentResources{
+					Elasticsearch: []*models.ElasticsearchResourceInfo{{
+						ID:    new(mock.ValidClusterID),
+						RefID: new("main-elasticsearch"),
+					}},
+				},
+			})),
+			resetResponse(),
+		)
+
+		err := resetCredentials(client, &model)
+		require.NoError(t, err)
+		require.Equal(t, types.StringNull(), model.RefID)
+		require.Equal(t, types.StringValue("new-password"), model.Password)
+	})
+
+	t.Run("returns the API error", func(t *testing.T) {
+		model := modelV0{
+			DeploymentID: types.StringValue(mock.ValidClusterID),
+			RefID:        types.StringValue("main-elasticsearch"),
+		}
+
+		err := resetCredentials(api.NewMock(mock.NewErrorResponse(404, mock.APIError{
+			Code: "deployments.deployment_not_found", Message: "deployment not found",
+		})), &model)
+		require.Error(t, err)
+		require.True(t, model.Password.IsNull())
+	})
+}
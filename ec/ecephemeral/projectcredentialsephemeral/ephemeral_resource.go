@@ -0,0 +1,156 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package projectcredentialsephemeral
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/elastic/terraform-provider-ec/ec/internal"
+	"github.com/elastic/terraform-provider-ec/ec/internal/gen/serverless"
+)
+
+var _ ephemeral.EphemeralResource = &EphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &EphemeralResource{}
+
+var projectTypes = []string{"elasticsearch", "observability", "security"}
+
+type EphemeralResource struct {
+	client serverless.ClientWithResponsesInterface
+}
+
+func (r *EphemeralResource) Configure(ctx context.Context, request ephemeral.ConfigureRequest, response *ephemeral.ConfigureResponse) {
+	clients, diags := internal.ConvertProviderData(request.ProviderData)
+	response.Diagnostics.Append(diags...)
+	r.client = clients.Serverless
+}
+
+func (r *EphemeralResource) Metadata(ctx context.Context, request ephemeral.MetadataRequest, response *ephemeral.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_project_credentials"
+}
+
+func (r *EphemeralResource) Schema(ctx context.Context, request ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		MarkdownDescription: `Resets the credentials of a serverless project and returns the new ones. The credentials are never persisted in the Terraform state or plan. Note that new credentials are generated each time Terraform opens this ephemeral resource, which invalidates the previous ones.`,
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the project to reset the credentials for.",
+				Required:            true,
+			},
+			"project_type": schema.StringAttribute{
+				MarkdownDescription: "Type of the project identified by `project_id`. One of `elasticsearch`, `observability` or `security`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(projectTypes...),
+				},
+			},
+			"username": schema.StringAttribute{
+				MarkdownDescription: "Basic auth username that can be used to access the Elasticsearch API.",
+				Computed:            true,
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "Basic auth password that can be used to access the Elasticsearch API.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+		},
+	}
+}
+
+type modelV0 struct {
+	ProjectID   types.String `tfsdk:"project_id"`
+	ProjectType types.String `tfsdk:"project_type"`
+	Username    types.String `tfsdk:"username"`
+	Password    types.String `tfsdk:"password"`
+}
+
+func (r *EphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		response.Diagnostics.AddError(
+			"Unconfigured API Client",
+			"Expected configured API client. Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	var model modelV0
+	response.Diagnostics.Append(request.Config.Get(ctx, &model)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	credentials, err := resetCredentials(ctx, r.client, model.ProjectType.ValueString(), model.ProjectID.ValueString())
+	if err != nil {
+		response.Diagnostics.AddError(
+			"Failed to reset project credentials",
+			fmt.Sprintf("Failed to reset the credentials of project %s: %s", model.ProjectID.ValueString(), err),
+		)
+		return
+	}
+
+	model.Username = types.StringValue(credentials.Username)
+	model.Password = types.StringValue(credentials.Password)
+
+	response.Diagnostics.Append(response.Result.Set(ctx, model)...)
+}
+
+func resetCredentials(ctx context.Context, client serverless.ClientWithResponsesInterface, projectType, id string) (*serverless.ProjectCredentials, error) {
+	var (
+		credentials *serverless.ProjectCredentials
+		statusCode  int
+		status      string
+		body        []byte
+	)
+
+	switch projectType {
+	case "elasticsearch":
+		resp, err := client.ResetElasticsearchProjectCredentialsWithResponse(ctx, id, nil)
+		if err != nil {
+			return nil, err
+		}
+		credentials, statusCode, status, body = resp.JSON200, resp.StatusCode(), resp.Status(), resp.Body
+	case "observability":
+		resp, err := client.ResetObservabilityProjectCredentialsWithResponse(ctx, id, nil)
+		if err != nil {
+			return nil, err
+		}
+		credentials, statusCode, status, body = resp.JSON200, resp.StatusCode(), resp.Status(), resp.Body
+	case "security":
+		resp, err := client.ResetSecurityProjectCredentialsWithResponse(ctx, id, nil)
+		if err != nil {
+			return nil, err
+		}
+		credentials, statusCode, status, body = resp.JSON200, resp.StatusCode(), resp.Status(), resp.Body
+	default:
+		return nil, fmt.Errorf("unsupported project type %q", projectType)
+	}
+
+	if credentials == nil {
+		return nil, fmt.Errorf("the API request failed with: %d %s\n%s", statusCode, status, body)
+	}
+
+	return credentials, nil
+}
@@ -0,0 +1,82 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package projectcredentialsephemeral
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/elastic/terraform-provider-ec/ec/internal/gen/serverless"
+	"github.com/elastic/terraform-provider-ec/ec/internal/gen/serverless/mocks"
+)
+
+func Test_resetCredentials(t *testing.T) {
+	ctx := context.Background()
+	credentials := &serverless.ProjectCredentials{Username: "admin", Password: "new-password"}
+
+	t.Run("resets elasticsearch project credentials", func(t *testing.T) {
+		client := mocks.NewMockClientWithResponsesInterface(gomock.NewController(t))
+		client.EXPECT().ResetElasticsearchProjectCredentialsWithResponse(ctx, "id", nil).Return(&serverless.ResetElasticsearchProjectCredentialsResponse{
+			HTTPResponse: &http.Response{StatusCode: 200},
+			JSON200:      credentials,
+		}, nil)
+
+		got, err := resetCredentials(ctx, client, "elasticsearch", "id")
+		require.NoError(t, err)
+		require.Equal(t, credentials, got)
+	})
+
+	t.Run("resets observability project credentials", func(t *testing.T) {
+		client := mocks.NewMockClientWithResponsesInterface(gomock.NewController(t))
+		client.EXPECT().ResetObservabilityProjectCredentialsWithResponse(ctx, "id", nil).Return(&serverless.ResetObservabilityProjectCredentialsResponse{
+			HTTPResponse: &http.Response{StatusCode: 200},
+			JSON200:      credentials,
+		}, nil)
+
+		got, err := resetCredentials(ctx, client, "observability", "id")
+		require.NoError(t, err)
+		require.Equal(t, credentials, got)
+	})
+
+	t.Run("resets security project credentials", func(t *testing.T) {
+		client := mocks.NewMockClientWithResponsesInterface(gomock.NewController(t))
+		client.EXPECT().ResetSecurityProjectCredentialsWithResponse(ctx, "id", nil).Return(&serverless.ResetSecurityProjectCredentialsResponse{
+			HTTPResponse: &http.Response{StatusCode: 200},
+			JSON200:      credentials,
+		}, nil)
+
+		got, err := resetCredentials(ctx, client, "security", "id")
+		require.NoError(t, err)
+		require.Equal(t, credentials, got)
+	})
+
+	t.Run("returns an error when the API call fails", func(t *testing.T) {
+		client := mocks.NewMockClientWithResponsesInterface(gomock.NewController(t))
+		client.EXPECT().ResetElasticsearchProjectCredentialsWithResponse(ctx, "id", nil).Return(&serverless.ResetElasticsearchProjectCredentialsResponse{
+			HTTPResponse: &http.Response{StatusCode: 409, Status: "409 Conflict"},
+			Body:         []byte("conflict"),
+		}, nil)
+
+		_, err := resetCredentials(ctx, client, "elasticsearch", "id")
+		require.EqualError(t, err, "the API request failed with: 409 409 Conflict\nconflict")
+	})
+}
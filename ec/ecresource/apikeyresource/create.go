@@ -0,0 +1,83 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package apikeyresource
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/elastic/cloud-sdk-go/pkg/api/apierror"
+	"github.com/elastic/cloud-sdk-go/pkg/api/organizationapi"
+	"github.com/elastic/cloud-sdk-go/pkg/client/authentication"
+)
+
+// Create will create a new API key
+func (r Resource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	if !resourceReady(r, &response.Diagnostics) {
+		return
+	}
+
+	var newState modelV0
+
+	diags := request.Plan.Get(ctx, &newState)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// The role assignments refer to the organization of the API key, which
+	// is the one of the provider credentials.
+	organizations, err := organizationapi.List(organizationapi.ListParams{API: r.client})
+	if err != nil {
+		response.Diagnostics.AddError("Listing organizations failed", err.Error())
+		return
+	}
+	if len(organizations) == 0 || organizations[0].ID == nil {
+		response.Diagnostics.AddError("Organization not found", "The provider credentials don't belong to any organization.")
+		return
+	}
+
+	apiKeyRequest, diags := expandModel(ctx, newState, *organizations[0].ID)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.client.V1API.Authentication.CreateAPIKey(
+		authentication.NewCreateAPIKeyParams().
+			WithContext(ctx).
+			WithBody(apiKeyRequest),
+		r.client.AuthWriter,
+	)
+	if err != nil {
+		response.Diagnostics.AddError("failed to create API key", apierror.Wrap(err).Error())
+		return
+	}
+
+	// The key is only returned once, it can't be read afterwards.
+	newState.Key = types.StringValue(res.Payload.Key)
+	response.Diagnostics.Append(modelToState(ctx, res.Payload, &newState)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Finally, set the state
+	response.Diagnostics.Append(response.State.Set(ctx, newState)...)
+}
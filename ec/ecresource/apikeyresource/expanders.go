@@ -0,0 +1,100 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package apikeyresource
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/elastic/cloud-sdk-go/pkg/models"
+
+	"github.com/elastic/terraform-provider-ec/ec/ecresource/organizationresource"
+)
+
+// roles returns the role assignments of the API key as the ones of an
+// organization member, to share the mappings of the organization resource.
+func (m modelV0) roles() organizationresource.OrganizationMember {
+	return organizationresource.OrganizationMember{
+		OrganizationRole:          m.OrganizationRole,
+		DeploymentRoles:           m.DeploymentRoles,
+		ProjectElasticsearchRoles: m.ProjectElasticsearchRoles,
+		ProjectObservabilityRoles: m.ProjectObservabilityRoles,
+		ProjectSecurityRoles:      m.ProjectSecurityRoles,
+	}
+}
+
+func expandModel(ctx context.Context, plan modelV0, organizationID string) (*models.CreateAPIKeyRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	roleAssignments := organizationresource.RoleAssignmentsToApi(ctx, plan.roles(), organizationID, &diags)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return &models.CreateAPIKeyRequest{
+		Description:     plan.Description.ValueStringPointer(),
+		Expiration:      plan.Expiration.ValueString(),
+		RoleAssignments: roleAssignments,
+	}, diags
+}
+
+// modelToState updates state with the API key, but the key itself, which is
+// only returned on creation.
+func modelToState(ctx context.Context, res *models.APIKeyResponse, state *modelV0) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	state.ID = types.StringPointerValue(res.ID)
+	state.Description = types.StringPointerValue(res.Description)
+	state.OrganizationID = stringOrNull(res.OrganizationID)
+	state.UserID = stringOrNull(res.UserID)
+	state.CreatedAt = dateTimeToModel(res.CreationDate)
+	state.ExpiresAt = types.StringNull()
+	if !time.Time(res.ExpirationDate).IsZero() {
+		state.ExpiresAt = dateTimeToModel(&res.ExpirationDate)
+	}
+
+	roles := organizationresource.MemberToModel(ctx, models.OrganizationMembership{RoleAssignments: res.RoleAssignments}, &diags)
+	if diags.HasError() {
+		return diags
+	}
+	state.OrganizationRole = roles.OrganizationRole
+	state.DeploymentRoles = roles.DeploymentRoles
+	state.ProjectElasticsearchRoles = roles.ProjectElasticsearchRoles
+	state.ProjectObservabilityRoles = roles.ProjectObservabilityRoles
+	state.ProjectSecurityRoles = roles.ProjectSecurityRoles
+
+	return diags
+}
+
+func stringOrNull(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
+
+func dateTimeToModel(value *strfmt.DateTime) types.String {
+	if value == nil {
+		return types.StringNull()
+	}
+	return types.StringValue(time.Time(*value).UTC().Format(time.RFC3339))
+}
@@ -0,0 +1,59 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package apikeyresource
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// now is replaced in tests to check the expiry of API keys.
+var now = time.Now
+
+// ModifyPlan replaces an expired API key by a new one.
+func (r Resource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	// Nothing to plan on create or destroy.
+	if request.State.Raw.IsNull() || request.Plan.Raw.IsNull() {
+		return
+	}
+
+	var state modelV0
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() || state.ExpiresAt.IsNull() {
+		return
+	}
+
+	expiresAt, err := time.Parse(time.RFC3339, state.ExpiresAt.ValueString())
+	if err != nil || now().Before(expiresAt) {
+		return
+	}
+
+	response.Diagnostics.AddAttributeWarning(
+		path.Root("expires_at"),
+		"API key expired",
+		fmt.Sprintf("The API key %s expired at %s and will be replaced by a new one.", state.ID.ValueString(), state.ExpiresAt.ValueString()),
+	)
+	// Terraform only replaces the resource when the attribute changes.
+	response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("expires_at"), types.StringUnknown())...)
+	response.RequiresReplace = append(response.RequiresReplace, path.Root("expires_at"))
+}
@@ -0,0 +1,224 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package apikeyresource
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/cloud-sdk-go/pkg/api"
+	"github.com/elastic/cloud-sdk-go/pkg/api/mock"
+	"github.com/elastic/cloud-sdk-go/pkg/models"
+)
+
+func TestResource(t *testing.T) {
+	ctx := context.Background()
+	sch := resourceSchema(t)
+
+	createdAt := strfmt.DateTime(time.Date(2026, 1, 10, 8, 0, 0, 0, time.UTC))
+	expiresAt := strfmt.DateTime(time.Date(2026, 4, 10, 8, 0, 0, 0, time.UTC))
+	roleAssignments := &models.RoleAssignments{
+		Deployment: []*models.DeploymentRoleAssignment{
+			{OrganizationID: new("123"), RoleID: new("deployment-editor"), All: new(true)},
+		},
+		Project: &models.ProjectRoleAssignments{},
+	}
+	apiKey := func(key string) *models.APIKeyResponse {
+		return &models.APIKeyResponse{
+			ID:              new("key-id"),
+			Key:             key,
+			Description:     new("ci"),
+			CreationDate:    &createdAt,
+			ExpirationDate:  expiresAt,
+			OrganizationID:  "123",
+			UserID:          "user",
+			RoleAssignments: roleAssignments,
+		}
+	}
+
+	plan := planModel(sch)
+
+	t.Run("creates the API key and stores the key", func(t *testing.T) {
+		r := Resource{client: api.NewMock(
+			mock.New200Response(mock.NewStructBody(models.OrganizationList{
+				Organizations: []*models.Organization{{ID: new("123"), Name: new("org")}},
+			})),
+			mock.New201ResponseAssertion(
+				&mock.RequestAssertion{
+					Host:   api.DefaultMockHost,
+					Header: api.DefaultWriteMockHeaders,
+					Method: "POST",
+					Path:   "/api/v1/users/auth/keys",
+					Body: mock.NewStructBody(models.CreateAPIKeyRequest{
+						Description: new("ci"),
+						Expiration:  "90d",
+						RoleAssignments: &models.RoleAssignments{
+							Deployment: []*models.DeploymentRoleAssignment{
+								{OrganizationID: new("123"), RoleID: new("deployment-editor"), All: new(true)},
+							},
+							Project: &models.ProjectRoleAssignments{},
+						},
+					}),
+				},
+				mock.NewStructBody(apiKey("secret")),
+			),
+		)}
+
+		tfPlan := tfsdk.Plan{Schema: sch}
+		require.False(t, tfPlan.Set(ctx, plan).HasError())
+
+		resp := resource.CreateResponse{State: tfsdk.State{Schema: sch, Raw: tftypes.NewValue(sch.Type().TerraformType(ctx), nil)}}
+		r.Create(ctx, resource.CreateRequest{Plan: tfPlan}, &resp)
+		require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+		var state modelV0
+		require.False(t, resp.State.Get(ctx, &state).HasError())
+		assert.Equal(t, types.StringValue("key-id"), state.ID)
+		assert.Equal(t, types.StringValue("secret"), state.Key)
+		assert.Equal(t, types.StringValue("2026-01-10T08:00:00Z"), state.CreatedAt)
+		assert.Equal(t, types.StringValue("2026-04-10T08:00:00Z"), state.ExpiresAt)
+		assert.Equal(t, plan.DeploymentRoles, state.DeploymentRoles)
+	})
+
+	t.Run("keeps the key, which isn't returned afterwards", func(t *testing.T) {
+		r := Resource{client: api.NewMock(mock.New200Response(mock.NewStructBody(apiKey(""))))}
+
+		state := createdState(plan)
+		tfState := tfsdk.State{Schema: sch}
+		require.False(t, tfState.Set(ctx, state).HasError())
+
+		resp := resource.ReadResponse{State: tfState}
+		r.Read(ctx, resource.ReadRequest{State: tfState}, &resp)
+		require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+		var got modelV0
+		require.False(t, resp.State.Get(ctx, &got).HasError())
+		assert.Equal(t, types.StringValue("secret"), got.Key)
+	})
+
+	t.Run("removes a deleted API key from the state", func(t *testing.T) {
+		r := Resource{client: api.NewMock(mock.New404Response(mock.NewStringBody(`{"errors":[{"code":"api_keys.key_not_found","message":"not found"}]}`)))}
+
+		tfState := tfsdk.State{Schema: sch}
+		require.False(t, tfState.Set(ctx, createdState(plan)).HasError())
+
+		resp := resource.ReadResponse{State: tfState}
+		r.Read(ctx, resource.ReadRequest{State: tfState}, &resp)
+		require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+		assert.True(t, resp.State.Raw.IsNull())
+	})
+}
+
+func TestModifyPlan(t *testing.T) {
+	ctx := context.Background()
+	sch := resourceSchema(t)
+
+	defer func(previous func() time.Time) { now = previous }(now)
+
+	state := createdState(planModel(sch))
+	tfState := tfsdk.State{Schema: sch}
+	require.False(t, tfState.Set(ctx, state).HasError())
+
+	tests := []struct {
+		name            string
+		now             time.Time
+		expectedReplace path.Paths
+	}{
+		{
+			name: "keeps an API key which hasn't expired",
+			now:  time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:            "replaces an expired API key",
+			now:             time.Date(2026, 4, 11, 0, 0, 0, 0, time.UTC),
+			expectedReplace: path.Paths{path.Root("expires_at")},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			now = func() time.Time { return tt.now }
+
+			tfPlan := tfsdk.Plan{Schema: sch, Raw: tfState.Raw.Copy()}
+			resp := resource.ModifyPlanResponse{Plan: tfPlan}
+			Resource{}.ModifyPlan(ctx, resource.ModifyPlanRequest{State: tfState, Plan: tfPlan}, &resp)
+			require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+			assert.Equal(t, tt.expectedReplace, resp.RequiresReplace)
+
+			var expiresAt types.String
+			require.False(t, resp.Plan.GetAttribute(ctx, path.Root("expires_at"), &expiresAt).HasError())
+			assert.Equal(t, tt.expectedReplace != nil, expiresAt.IsUnknown())
+		})
+	}
+}
+
+func planModel(sch schema.Schema) modelV0 {
+	deploymentRoleType := sch.Attributes["deployment_roles"].(schema.SetNestedAttribute).NestedObject.Type().(types.ObjectType)
+	projectRoles := types.SetValueMust(sch.Attributes["project_elasticsearch_roles"].(schema.SetNestedAttribute).NestedObject.Type(), []attr.Value{})
+	return modelV0{
+		ID:             types.StringUnknown(),
+		Description:    types.StringValue("ci"),
+		Expiration:     types.StringValue("90d"),
+		Key:            types.StringUnknown(),
+		OrganizationID: types.StringUnknown(),
+		UserID:         types.StringUnknown(),
+		CreatedAt:      types.StringUnknown(),
+		ExpiresAt:      types.StringUnknown(),
+		DeploymentRoles: types.SetValueMust(deploymentRoleType, []attr.Value{
+			types.ObjectValueMust(deploymentRoleType.AttrTypes, map[string]attr.Value{
+				"role":              types.StringValue("editor"),
+				"all_deployments":   types.BoolValue(true),
+				"deployment_ids":    types.SetNull(types.StringType),
+				"application_roles": types.SetNull(types.StringType),
+			}),
+		}),
+		ProjectElasticsearchRoles: projectRoles,
+		ProjectObservabilityRoles: projectRoles,
+		ProjectSecurityRoles:      projectRoles,
+	}
+}
+
+func resourceSchema(t *testing.T) schema.Schema {
+	var resp resource.SchemaResponse
+	(&Resource{}).Schema(context.Background(), resource.SchemaRequest{}, &resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+	return resp.Schema
+}
+
+func createdState(plan modelV0) modelV0 {
+	state := plan
+	state.ID = types.StringValue("key-id")
+	state.Key = types.StringValue("secret")
+	state.OrganizationID = types.StringValue("123")
+	state.UserID = types.StringValue("user")
+	state.CreatedAt = types.StringValue("2026-01-10T08:00:00Z")
+	state.ExpiresAt = types.StringValue("2026-04-10T08:00:00Z")
+	return state
+}
@@ -0,0 +1,187 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package apikeyresource
+
+import (
+	"context"
+	"regexp"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/elastic/cloud-sdk-go/pkg/api"
+
+	"github.com/elastic/terraform-provider-ec/ec/ecresource/organizationresource"
+	"github.com/elastic/terraform-provider-ec/ec/internal"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &Resource{}
+var _ resource.ResourceWithConfigure = &Resource{}
+var _ resource.ResourceWithImportState = &Resource{}
+var _ resource.ResourceWithModifyPlan = &Resource{}
+
+func (r *Resource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "Unique identifier of the API key.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"description": schema.StringAttribute{
+			MarkdownDescription: "Description of the API key.",
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"expiration": schema.StringAttribute{
+			MarkdownDescription: "How long the API key is valid, as a number of hours or days, e.g. `12h` or `90d`. The API key never expires when not set. An expired API key is replaced by a new one on the next apply.",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(regexp.MustCompile(`^[1-9][0-9]*[hd]$`), "must be a number of hours or days, e.g. 12h or 90d"),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"rotation_trigger": schema.StringAttribute{
+			MarkdownDescription: "Arbitrary value which replaces the API key with a new one whenever it changes, e.g. the ID of a `time_rotating` resource. Use the `create_before_destroy` lifecycle to create the new API key before the previous one is deleted.",
+			Optional:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"key": schema.StringAttribute{
+			MarkdownDescription: "The API key. It is only returned when the API key is created, and is null for an imported API key.",
+			Computed:            true,
+			Sensitive:           true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"organization_id": schema.StringAttribute{
+			MarkdownDescription: "ID of the organization the API key belongs to.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"user_id": schema.StringAttribute{
+			MarkdownDescription: "ID of the user who created the API key.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"created_at": schema.StringAttribute{
+			MarkdownDescription: "Date and time (RFC 3339) when the API key was created.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"expires_at": schema.StringAttribute{
+			MarkdownDescription: "Date and time (RFC 3339) when the API key expires, null if it never expires.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+	}
+
+	// API keys can't be updated, so granting other roles requires a new API key.
+	for name, attribute := range organizationresource.RoleAssignmentAttributes() {
+		switch a := attribute.(type) {
+		case schema.StringAttribute:
+			a.PlanModifiers = append(slices.Clone(a.PlanModifiers), stringplanmodifier.RequiresReplace())
+			attribute = a
+		case schema.SetNestedAttribute:
+			a.PlanModifiers = append(slices.Clone(a.PlanModifiers), setplanmodifier.RequiresReplace())
+			attribute = a
+		}
+		attributes[name] = attribute
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Provides an Elastic Cloud API key resource, which allows organization API keys to be created and rotated.
+
+  ~> **This resource can only be used with Elastic Cloud SaaS**
+
+  ~> **Note on API keys** The API key is stored in the Terraform state, which must be secured accordingly.`,
+		Attributes: attributes,
+	}
+}
+
+type Resource struct {
+	client *api.API
+}
+
+func resourceReady(r Resource, dg *diag.Diagnostics) bool {
+	if r.client == nil {
+		dg.AddError(
+			"Unconfigured API Client",
+			"Expected configured API client. Please report this issue to the provider developers.",
+		)
+
+		return false
+	}
+	return true
+}
+
+func (r *Resource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("id"), request.ID)...)
+}
+
+func (r *Resource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	clients, diags := internal.ConvertProviderData(request.ProviderData)
+	response.Diagnostics.Append(diags...)
+	r.client = clients.Stateful
+}
+
+func (r *Resource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_api_key"
+}
+
+type modelV0 struct {
+	ID                        types.String `tfsdk:"id"`
+	Description               types.String `tfsdk:"description"`
+	Expiration                types.String `tfsdk:"expiration"`
+	RotationTrigger           types.String `tfsdk:"rotation_trigger"`
+	Key                       types.String `tfsdk:"key"`
+	OrganizationID            types.String `tfsdk:"organization_id"`
+	UserID                    types.String `tfsdk:"user_id"`
+	CreatedAt                 types.String `tfsdk:"created_at"`
+	ExpiresAt                 types.String `tfsdk:"expires_at"`
+	OrganizationRole          types.String `tfsdk:"organization_role"`
+	DeploymentRoles           types.Set    `tfsdk:"deployment_roles"`            //< organizationresource.DeploymentRoleAssignment
+	ProjectElasticsearchRoles types.Set    `tfsdk:"project_elasticsearch_roles"` //< organizationresource.ProjectRoleAssignment
+	ProjectObservabilityRoles types.Set    `tfsdk:"project_observability_roles"` //< organizationresource.ProjectRoleAssignment
+	ProjectSecurityRoles      types.Set    `tfsdk:"project_security_roles"`      //< organizationresource.ProjectRoleAssignment
+}
@@ -22,8 +22,10 @@ import (
 	"fmt"
 	"github.com/elastic/cloud-sdk-go/pkg/api/deploymentapi"
 	v2 "github.com/elastic/terraform-provider-ec/ec/ecresource/deploymentresource/deployment/v2"
+	elasticsearchv2 "github.com/elastic/terraform-provider-ec/ec/ecresource/deploymentresource/elasticsearch/v2"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
@@ -46,6 +48,12 @@ func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 		return
 	}
 
+	plan.Elasticsearch, diags = elasticsearchv2.ElasticsearchWithKeystoreWriteOnlyValues(ctx, plan.Elasticsearch, config.Elasticsearch)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	request, diags := plan.CreateRequest(ctx, r.client)
 	if diags.HasError() {
 		resp.Diagnostics.Append(diags...)
@@ -94,6 +102,18 @@ func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 		filters = request.Settings.TrafficFilterSettings.Rulesets
 	}
 
+	// In exclusive mode, the rulesets included by default are disassociated
+	// from the new deployment.
+	if plan.TrafficFilterMode.ValueString() == v2.TrafficFilterModeExclusive {
+		plan.Id = types.StringValue(*res.ID)
+		currentRules, diags := trafficFiltersToReconcile(r.client, plan, filters)
+		resp.Diagnostics.Append(diags...)
+		if !diags.HasError() {
+			filters, diags = HandleTrafficFilterChange(ctx, r.client, plan, currentRules)
+			resp.Diagnostics.Append(diags...)
+		}
+	}
+
 	deployment, diags := r.read(ctx, *res.ID, nil, &plan, res.Resources, filters, nil)
 	updatePrivateStateTrafficFilters(ctx, resp.Private, filters)
 
@@ -48,6 +48,7 @@ type DeploymentTF struct {
 	ElasticsearchPassword      types.String `tfsdk:"elasticsearch_password"`
 	ApmSecretToken             types.String `tfsdk:"apm_secret_token"`
 	TrafficFilter              types.Set    `tfsdk:"traffic_filter"`
+	TrafficFilterMode          types.String `tfsdk:"traffic_filter_mode"`
 	Tags                       types.Map    `tfsdk:"tags"`
 	Elasticsearch              types.Object `tfsdk:"elasticsearch"`
 	Kibana                     types.Object `tfsdk:"kibana"`
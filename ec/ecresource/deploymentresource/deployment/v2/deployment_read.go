@@ -58,6 +58,7 @@ type Deployment struct {
 	ElasticsearchPassword      string                                   `tfsdk:"elasticsearch_password"`
 	ApmSecretToken             *string                                  `tfsdk:"apm_secret_token"`
 	TrafficFilter              []string                                 `tfsdk:"traffic_filter"`
+	TrafficFilterMode          *string                                  `tfsdk:"traffic_filter_mode"`
 	Tags                       map[string]string                        `tfsdk:"tags"`
 	Elasticsearch              *elasticsearchv2.Elasticsearch           `tfsdk:"elasticsearch"`
 	Kibana                     *kibanav2.Kibana                         `tfsdk:"kibana"`
@@ -337,6 +338,15 @@ func (dep *Deployment) ProcessSelfInObservability(ctx context.Context, base Depl
 }
 
 func (dep *Deployment) IncludePrivateStateTrafficFilters(ctx context.Context, base DeploymentTF, privateFilters []string) diag.Diagnostics {
+	// In exclusive mode, every ruleset associated to the deployment is
+	// reported, so that the unlisted ones show up as drift.
+	if base.TrafficFilterMode.ValueString() == TrafficFilterModeExclusive {
+		if dep.TrafficFilter == nil {
+			dep.TrafficFilter = []string{}
+		}
+		return nil
+	}
+
 	var baseFilters []string
 	diags := base.TrafficFilter.ElementsAs(ctx, &baseFilters, true)
 	if diags.HasError() {
@@ -18,6 +18,7 @@
 package v2
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -35,6 +36,12 @@ import (
 	"github.com/elastic/terraform-provider-ec/ec/internal/planmodifiers"
 )
 
+// Modes of the `traffic_filter_mode` attribute.
+const (
+	TrafficFilterModeMerge     = "merge"
+	TrafficFilterModeExclusive = "exclusive"
+)
+
 func DeploymentSchema() schema.Schema {
 	return schema.Schema{
 		Version:             2,
@@ -122,6 +129,17 @@ func DeploymentSchema() schema.Schema {
 					planmodifiers.SetDefaultValue(types.StringType, []attr.Value{}),
 				},
 			},
+			"traffic_filter_mode": schema.StringAttribute{
+				Description: `How the rulesets of ` + "`traffic_filter`" + ` are applied to the deployment (Defaults to ` + "`merge`" + `). In ` + "`merge`" + ` mode, rulesets associated to the deployment outside of ` + "`traffic_filter`" + `, e.g. rulesets included by default, are left as they are. In ` + "`exclusive`" + ` mode, only the rulesets of ` + "`traffic_filter`" + ` may be associated to the deployment: other rulesets, including the ones included by default, are reported as drift and disassociated from the deployment.`,
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(TrafficFilterModeMerge, TrafficFilterModeExclusive),
+				},
+				PlanModifiers: []planmodifier.String{
+					planmodifiers.StringDefaultValue(TrafficFilterModeMerge),
+				},
+			},
 			"tags": schema.MapAttribute{
 				Description: "Optional map of deployment tags",
 				ElementType: types.StringType,
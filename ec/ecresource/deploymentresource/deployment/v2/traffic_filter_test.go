@@ -159,3 +159,58 @@ func Test_trafficFilterToModel(t *testing.T) {
 		})
 	}
 }
+
+func TestIncludePrivateStateTrafficFilters(t *testing.T) {
+	tests := []struct {
+		name           string
+		mode           types.String
+		baseFilters    []string
+		privateFilters []string
+		associated     []string
+		want           []string
+	}{
+		{
+			name:           "merge mode hides the rulesets which aren't managed by terraform",
+			mode:           types.StringValue(TrafficFilterModeMerge),
+			baseFilters:    []string{"rule1"},
+			privateFilters: []string{"rule1", "rule2"},
+			associated:     []string{"rule1", "rule2", "default"},
+			want:           []string{"rule1", "rule2"},
+		},
+		{
+			name:           "unset mode behaves like merge mode",
+			mode:           types.StringNull(),
+			baseFilters:    []string{"rule1"},
+			privateFilters: []string{"rule1"},
+			associated:     []string{"rule1", "default"},
+			want:           []string{"rule1"},
+		},
+		{
+			name:           "exclusive mode reports every associated ruleset",
+			mode:           types.StringValue(TrafficFilterModeExclusive),
+			baseFilters:    []string{"rule1"},
+			privateFilters: []string{"rule1"},
+			associated:     []string{"rule1", "default"},
+			want:           []string{"rule1", "default"},
+		},
+		{
+			name:        "exclusive mode reports an empty set without associated rulesets",
+			mode:        types.StringValue(TrafficFilterModeExclusive),
+			baseFilters: []string{"rule1"},
+			want:        []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			baseFilters, diags := types.SetValueFrom(ctx, types.StringType, tt.baseFilters)
+			assert.Nil(t, diags)
+
+			base := DeploymentTF{TrafficFilter: baseFilters, TrafficFilterMode: tt.mode}
+			dep := Deployment{TrafficFilter: tt.associated}
+
+			assert.Nil(t, dep.IncludePrivateStateTrafficFilters(ctx, base, tt.privateFilters))
+			assert.Equal(t, tt.want, dep.TrafficFilter)
+		})
+	}
+}
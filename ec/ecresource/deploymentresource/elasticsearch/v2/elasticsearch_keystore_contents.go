@@ -27,13 +27,17 @@ import (
 )
 
 type ElasticsearchKeystoreContentsTF struct {
-	Value  types.String `tfsdk:"value"`
-	AsFile types.Bool   `tfsdk:"as_file"`
+	Value          types.String `tfsdk:"value"`
+	ValueWO        types.String `tfsdk:"value_wo"`
+	ValueWOVersion types.Int64  `tfsdk:"value_wo_version"`
+	AsFile         types.Bool   `tfsdk:"as_file"`
 }
 
 type ElasticsearchKeystoreContents struct {
-	Value  string `tfsdk:"value"`
-	AsFile *bool  `tfsdk:"as_file"`
+	Value          *string `tfsdk:"value"`
+	ValueWO        *string `tfsdk:"value_wo"`
+	ValueWOVersion *int64  `tfsdk:"value_wo_version"`
+	AsFile         *bool   `tfsdk:"as_file"`
 }
 
 func elasticsearchKeystoreContentsPayload(ctx context.Context, keystoreContentsTF types.Map, model *models.ElasticsearchClusterSettings, esState *ElasticsearchTF) (*models.ElasticsearchClusterSettings, diag.Diagnostics) {
@@ -63,6 +67,9 @@ func elasticsearchKeystoreContentsPayload(ctx context.Context, keystoreContentsT
 			secret.AsFile = new(secretTF.AsFile.ValueBool())
 		}
 		secret.Value = secretTF.Value.ValueString()
+		if secretTF.Value.IsNull() {
+			secret.Value = secretTF.ValueWO.ValueString()
+		}
 
 		secrets[secretKey] = secret
 	}
@@ -94,3 +101,55 @@ func elasticsearchKeystoreContentsPayload(ctx context.Context, keystoreContentsT
 
 	return model, nil
 }
+
+// ElasticsearchWithKeystoreWriteOnlyValues copies the write-only `value_wo` of
+// every `keystore_contents` entry from the configuration into the plan, since
+// Terraform never includes write-only values in the plan.
+func ElasticsearchWithKeystoreWriteOnlyValues(ctx context.Context, plan types.Object, config types.Object) (types.Object, diag.Diagnostics) {
+	esPlan, diags := objectToElasticsearch(ctx, plan)
+	if diags.HasError() || esPlan == nil {
+		return plan, diags
+	}
+
+	esConfig, diags := objectToElasticsearch(ctx, config)
+	if diags.HasError() || esConfig == nil {
+		return plan, diags
+	}
+
+	if esPlan.KeystoreContents.IsNull() || esPlan.KeystoreContents.IsUnknown() ||
+		esConfig.KeystoreContents.IsNull() || esConfig.KeystoreContents.IsUnknown() {
+		return plan, nil
+	}
+
+	var planContents, configContents map[string]ElasticsearchKeystoreContentsTF
+	diags.Append(esPlan.KeystoreContents.ElementsAs(ctx, &planContents, false)...)
+	diags.Append(esConfig.KeystoreContents.ElementsAs(ctx, &configContents, false)...)
+	if diags.HasError() {
+		return plan, diags
+	}
+
+	var found bool
+	for name, entry := range planContents {
+		if cfg, ok := configContents[name]; ok && !cfg.ValueWO.IsNull() {
+			entry.ValueWO = cfg.ValueWO
+			planContents[name] = entry
+			found = true
+		}
+	}
+
+	if !found {
+		return plan, nil
+	}
+
+	var ds diag.Diagnostics
+	esPlan.KeystoreContents, ds = types.MapValueFrom(ctx, esPlan.KeystoreContents.ElementType(ctx), planContents)
+	diags.Append(ds...)
+	if diags.HasError() {
+		return plan, diags
+	}
+
+	res, ds := types.ObjectValueFrom(ctx, plan.AttributeTypes(ctx), esPlan)
+	diags.Append(ds...)
+
+	return res, diags
+}
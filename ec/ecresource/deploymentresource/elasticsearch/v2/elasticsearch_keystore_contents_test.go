@@ -0,0 +1,79 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package v2
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ElasticsearchWithKeystoreWriteOnlyValues(t *testing.T) {
+	toObject := func(t *testing.T, es Elasticsearch) types.Object {
+		var obj types.Object
+		diags := tfsdk.ValueFrom(context.Background(), es, ElasticsearchSchema().GetType(), &obj)
+		require.False(t, diags.HasError())
+		return obj
+	}
+
+	t.Run("copies write-only values from the configuration into the plan", func(t *testing.T) {
+		plan := toObject(t, Elasticsearch{
+			KeystoreContents: map[string]ElasticsearchKeystoreContents{
+				"gcs.client.default.credentials_file": {ValueWOVersion: new(int64(2)), AsFile: new(true)},
+				"plain":                               {Value: new("plain-value"), AsFile: new(false)},
+			},
+		})
+		config := toObject(t, Elasticsearch{
+			KeystoreContents: map[string]ElasticsearchKeystoreContents{
+				"gcs.client.default.credentials_file": {ValueWO: new("secret"), ValueWOVersion: new(int64(2)), AsFile: new(true)},
+				"plain":                               {Value: new("plain-value")},
+			},
+		})
+
+		got, diags := ElasticsearchWithKeystoreWriteOnlyValues(context.Background(), plan, config)
+		require.False(t, diags.HasError())
+
+		es, diags := objectToElasticsearch(context.Background(), got)
+		require.False(t, diags.HasError())
+
+		var contents map[string]ElasticsearchKeystoreContents
+		require.False(t, es.KeystoreContents.ElementsAs(context.Background(), &contents, false).HasError())
+		assert.Equal(t, map[string]ElasticsearchKeystoreContents{
+			"gcs.client.default.credentials_file": {ValueWO: new("secret"), ValueWOVersion: new(int64(2)), AsFile: new(true)},
+			"plain":                               {Value: new("plain-value"), AsFile: new(false)},
+		}, contents)
+
+		payload, diags := elasticsearchKeystoreContentsPayload(context.Background(), es.KeystoreContents, nil, nil)
+		require.False(t, diags.HasError())
+		assert.Equal(t, "secret", payload.KeystoreContents.Secrets["gcs.client.default.credentials_file"].Value)
+		assert.Equal(t, "plain-value", payload.KeystoreContents.Secrets["plain"].Value)
+	})
+
+	t.Run("returns the plan unchanged without keystore contents", func(t *testing.T) {
+		plan := toObject(t, Elasticsearch{})
+		config := toObject(t, Elasticsearch{})
+
+		got, diags := ElasticsearchWithKeystoreWriteOnlyValues(context.Background(), plan, config)
+		require.False(t, diags.HasError())
+		assert.Equal(t, plan, got)
+	})
+}
@@ -2573,10 +2573,10 @@ func Test_writeElasticsearch(t *testing.T) {
 					},
 					KeystoreContents: map[string]ElasticsearchKeystoreContents{
 						"secret-key1": {
-							Value: "secret-text-value",
+							Value: new("secret-text-value"),
 						},
 						"secret-key2": {
-							Value:  "secret-file-value",
+							Value:  new("secret-file-value"),
 							AsFile: new(true),
 						},
 					},
@@ -2592,7 +2592,7 @@ func Test_writeElasticsearch(t *testing.T) {
 					},
 					KeystoreContents: map[string]ElasticsearchKeystoreContents{
 						"secret-key-to-remove": {
-							Value: "obsolete-secret-value",
+							Value: new("obsolete-secret-value"),
 						},
 					},
 				},
@@ -25,7 +25,9 @@ import (
 
 	"github.com/elastic/terraform-provider-ec/ec/internal/planmodifiers"
 	"github.com/elastic/terraform-provider-ec/ec/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
@@ -166,14 +168,33 @@ func ElasticsearchSchema() schema.Attribute {
 
 func keystoreContentsSchema() schema.Attribute {
 	return schema.MapNestedAttribute{
-		Description: "Keystore contents that are controlled by the deployment resource.",
+		Description: "Keystore contents that are controlled by the deployment resource. The values of known secure settings, such as GCS credentials or S3 client keys, are validated at plan time.",
 		Optional:    true,
+		Validators: []validator.Map{
+			validators.KeystoreSettings(),
+		},
 		NestedObject: schema.NestedAttributeObject{
 			Attributes: map[string]schema.Attribute{
 				"value": schema.StringAttribute{
-					Description: "Secret value. This can either be a string or a JSON object that is stored as a JSON string in the keystore.",
-					Required:    true,
+					Description: "Secret value. This can either be a string or a JSON object that is stored as a JSON string in the keystore. Exactly one of `value` or `value_wo` must be set.",
+					Optional:    true,
+					Sensitive:   true,
+					Validators: []validator.String{
+						stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("value_wo")),
+					},
+				},
+				"value_wo": schema.StringAttribute{
+					Description: "Write-only secret value, which is never stored in the Terraform state or plan. Since the value isn't stored, changes to it are only applied when `value_wo_version` changes. Requires Terraform 1.11 or later.",
+					Optional:    true,
 					Sensitive:   true,
+					WriteOnly:   true,
+				},
+				"value_wo_version": schema.Int64Attribute{
+					Description: "Version of the write-only `value_wo`. Change this value to push an updated `value_wo` to the keystore.",
+					Optional:    true,
+					Validators: []validator.Int64{
+						int64validator.AlsoRequires(path.MatchRelative().AtParent().AtName("value_wo")),
+					},
 				},
 				"as_file": schema.BoolAttribute{
 					Description: "If true, the secret is handled as a file. Otherwise, it's handled as a plain string.",
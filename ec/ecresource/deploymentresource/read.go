@@ -175,6 +175,11 @@ func (r *Resource) read(ctx context.Context, id string, state *deploymentv2.Depl
 		deployment.MigrateToLatestHardware = base.MigrateToLatestHardware.ValueBoolPointer()
 	}
 
+	deployment.TrafficFilterMode = new(deploymentv2.TrafficFilterModeMerge)
+	if !base.TrafficFilterMode.IsNull() && !base.TrafficFilterMode.IsUnknown() {
+		deployment.TrafficFilterMode = base.TrafficFilterMode.ValueStringPointer()
+	}
+
 	diags.Append(deployment.IncludePrivateStateTrafficFilters(ctx, base, privateFilters)...)
 
 	deployment.SetCredentialsIfEmpty(state)
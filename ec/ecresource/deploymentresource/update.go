@@ -19,11 +19,14 @@ package deploymentresource
 
 import (
 	"context"
+	"fmt"
 	"github.com/elastic/cloud-sdk-go/pkg/api"
 	"github.com/elastic/cloud-sdk-go/pkg/api/deploymentapi"
 	"github.com/elastic/cloud-sdk-go/pkg/api/deploymentapi/depresourceapi"
+	"github.com/elastic/cloud-sdk-go/pkg/api/deploymentapi/deputil"
 	"github.com/elastic/cloud-sdk-go/pkg/api/deploymentapi/trafficfilterapi"
 	v2 "github.com/elastic/terraform-provider-ec/ec/ecresource/deploymentresource/deployment/v2"
+	elasticsearchv2 "github.com/elastic/terraform-provider-ec/ec/ecresource/deploymentresource/elasticsearch/v2"
 	"github.com/elastic/terraform-provider-ec/ec/internal/util"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -47,6 +50,22 @@ func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp
 		return
 	}
 
+	var config v2.DeploymentTF
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var diags diag.Diagnostics
+	plan.Elasticsearch, diags = elasticsearchv2.ElasticsearchWithKeystoreWriteOnlyValues(ctx, plan.Elasticsearch, config.Elasticsearch)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Read migrate request from private state
 	migrateTemplateRequest, diags := ReadPrivateStateMigrateTemplateRequest(ctx, req.Private)
 
@@ -86,12 +105,20 @@ func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	planRules, diags := HandleTrafficFilterChange(ctx, r.client, plan, privateFilters)
+	currentRules, diags := trafficFiltersToReconcile(r.client, plan, privateFilters)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the rules which were applied are recorded, so that the failed
+	// changes show up as drift and are retried on the next apply.
+	appliedRules, diags := HandleTrafficFilterChange(ctx, r.client, plan, currentRules)
 	resp.Diagnostics.Append(diags...)
-	updatePrivateStateTrafficFilters(ctx, resp.Private, planRules)
+	resp.Diagnostics.Append(updatePrivateStateTrafficFilters(ctx, resp.Private, appliedRules)...)
 	resp.Diagnostics.Append(v2.HandleRemoteClusters(ctx, r.client, plan.Id.ValueString(), plan.Elasticsearch)...)
 
-	deployment, diags := r.read(ctx, plan.Id.ValueString(), &state, &plan, res.Resources, planRules, nil)
+	deployment, diags := r.read(ctx, plan.Id.ValueString(), &state, &plan, res.Resources, appliedRules, nil)
 
 	resp.Diagnostics.Append(diags...)
 
@@ -131,10 +158,19 @@ func (r *Resource) ResetElasticsearchPassword(deploymentID string, refID string)
 	return *resetResp.Username, *resetResp.Password, diags
 }
 
+// trafficFilterConcurrency bounds the number of traffic filter associations
+// changed at the same time.
+const trafficFilterConcurrency = 8
+
+// HandleTrafficFilterChange associates the deployment to the rulesets of the
+// plan which aren't in stateRules, and removes the associations of the other
+// rulesets of stateRules, running the changes concurrently. It returns the
+// rulesets which are associated once done: a failed change is left out so
+// that it's retried on the next apply.
 func HandleTrafficFilterChange(ctx context.Context, client *api.API, plan v2.DeploymentTF, stateRules ruleSet) ([]string, diag.Diagnostics) {
 	var planRules ruleSet
 	if diags := plan.TrafficFilter.ElementsAs(ctx, &planRules, true); diags.HasError() {
-		return []string{}, diags
+		return slices.Clone(stateRules), diags
 	}
 
 	var rulesToAdd, rulesToDelete []string
@@ -151,20 +187,80 @@ func HandleTrafficFilterChange(ctx context.Context, client *api.API, plan v2.Dep
 		}
 	}
 
+	deploymentID := plan.Id.ValueString()
+	appliedRules := slices.Clone(stateRules)
+
 	var diags diag.Diagnostics
-	for _, rule := range rulesToAdd {
-		if err := associateRule(rule, plan.Id.ValueString(), client); err != nil {
-			diags.AddError("cannot associate traffic filter rule", err.Error())
+	errs := util.ForEachConcurrently(rulesToAdd, trafficFilterConcurrency, func(rule string) error {
+		return associateRule(rule, deploymentID, client)
+	})
+	for i, err := range errs {
+		if err != nil {
+			diags.AddError("cannot associate traffic filter rule", fmt.Sprintf("%s: %s", rulesToAdd[i], err))
+			continue
 		}
+		appliedRules = append(appliedRules, rulesToAdd[i])
 	}
 
-	for _, rule := range rulesToDelete {
-		if err := removeRule(rule, plan.Id.ValueString(), client); err != nil {
-			diags.AddError("cannot remove traffic filter rule", err.Error())
+	errs = util.ForEachConcurrently(rulesToDelete, trafficFilterConcurrency, func(rule string) error {
+		return removeRule(rule, deploymentID, client)
+	})
+	for i, err := range errs {
+		if err != nil {
+			diags.AddError("cannot remove traffic filter rule", fmt.Sprintf("%s: %s", rulesToDelete[i], err))
+			continue
+		}
+		appliedRules = slices.DeleteFunc(appliedRules, func(rule string) bool { return rule == rulesToDelete[i] })
+	}
+
+	if appliedRules == nil {
+		appliedRules = []string{}
+	}
+	return appliedRules, diags
+}
+
+// trafficFiltersToReconcile returns the rulesets HandleTrafficFilterChange
+// compares the plan with. In merge mode, only the rulesets managed by Terraform
+// are considered. In exclusive mode, every ruleset associated to the deployment
+// is, so that the ones missing from the plan are disassociated.
+func trafficFiltersToReconcile(client *api.API, plan v2.DeploymentTF, privateFilters []string) ([]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if plan.TrafficFilterMode.ValueString() != v2.TrafficFilterModeExclusive {
+		return privateFilters, diags
+	}
+
+	associated, err := AssociatedTrafficFilters(client, plan.Id.ValueString())
+	if err != nil {
+		diags.AddError("cannot read the deployment traffic filters", err.Error())
+		return nil, diags
+	}
+
+	rules := slices.Clone(privateFilters)
+	for _, rule := range associated {
+		if !slices.Contains(rules, rule) {
+			rules = append(rules, rule)
 		}
 	}
+	return rules, diags
+}
+
+// AssociatedTrafficFilters returns the rulesets associated to the deployment.
+var AssociatedTrafficFilters = func(client *api.API, deploymentID string) ([]string, error) {
+	res, err := deploymentapi.Get(deploymentapi.GetParams{
+		API:          client,
+		DeploymentID: deploymentID,
+		QueryParams: deputil.QueryParams{
+			ShowSettings: true,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
 
-	return planRules, diags
+	if res.Settings == nil || res.Settings.TrafficFilterSettings == nil {
+		return nil, nil
+	}
+	return res.Settings.TrafficFilterSettings.Rulesets, nil
 }
 
 type ruleSet []string
@@ -190,3 +190,62 @@ func Test_handleTrafficFilterChange(t *testing.T) {
 	}
 
 }
+
+func Test_handleTrafficFilterChange_partialFailure(t *testing.T) {
+	deploymentID := "deployment_unique_id"
+
+	getRule := deploymentresource.GetAssociation
+	createRule := deploymentresource.CreateAssociation
+	deleteRule := deploymentresource.DeleteAssociation
+
+	defer func() {
+		deploymentresource.GetAssociation = getRule
+		deploymentresource.CreateAssociation = createRule
+		deploymentresource.DeleteAssociation = deleteRule
+	}()
+
+	deploymentresource.CreateAssociation = func(params trafficfilterapi.CreateAssociationParams) error {
+		if params.ID == "rule3" {
+			return fmt.Errorf("failed to associate %s", params.ID)
+		}
+		return nil
+	}
+	deploymentresource.DeleteAssociation = func(params trafficfilterapi.DeleteAssociationParams) error {
+		if params.ID == "rule5" {
+			return fmt.Errorf("failed to remove %s", params.ID)
+		}
+		return nil
+	}
+
+	// rule2 and rule3 aren't associated yet, the other rules are.
+	deploymentresource.GetAssociation = func(params trafficfilterapi.GetParams) (*models.TrafficFilterRulesetInfo, error) {
+		if params.ID == "rule2" || params.ID == "rule3" {
+			return &models.TrafficFilterRulesetInfo{}, nil
+		}
+		return &models.TrafficFilterRulesetInfo{
+			Associations: []*models.FilterAssociation{
+				{ID: &deploymentID, EntityType: new("deployment")},
+			},
+		}, nil
+	}
+
+	plan := v2.Deployment{
+		Id:            deploymentID,
+		TrafficFilter: []string{"rule1", "rule2", "rule3"},
+	}
+
+	var planTF v2.DeploymentTF
+	diags := tfsdk.ValueFrom(context.Background(), &plan, v2.DeploymentSchema().Type(), &planTF)
+	assert.Nil(t, diags)
+
+	filters, diags := deploymentresource.HandleTrafficFilterChange(context.Background(), nil, planTF, []string{"rule1", "rule4", "rule5"})
+
+	assert.Len(t, diags, 2)
+	assert.Equal(t, "cannot associate traffic filter rule", diags[0].Summary())
+	assert.Equal(t, "rule3: failed to associate rule3", diags[0].Detail())
+	assert.Equal(t, "cannot remove traffic filter rule", diags[1].Summary())
+	assert.Equal(t, "rule5: failed to remove rule5", diags[1].Detail())
+
+	// Only the changes which were applied are recorded.
+	assert.ElementsMatch(t, []string{"rule1", "rule2", "rule5"}, filters)
+}
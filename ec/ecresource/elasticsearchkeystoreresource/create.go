@@ -21,6 +21,7 @@ import (
 	"context"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
@@ -42,6 +43,12 @@ func (r Resource) Create(ctx context.Context, request resource.CreateRequest, re
 		return
 	}
 
+	// Write-only values are only available in the configuration.
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root("value_wo"), &newState.ValueWO)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	if _, err := eskeystoreapi.Update(eskeystoreapi.UpdateParams{
 		API:          r.client,
 		DeploymentID: newState.DeploymentID.ValueString(),
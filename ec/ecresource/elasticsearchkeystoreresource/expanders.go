@@ -25,22 +25,30 @@ import (
 )
 
 func expandModel(ctx context.Context, state modelV0) *models.KeystoreContents {
-	var value any
 	secretName := state.SettingName.ValueString()
 	strVal := state.Value.ValueString()
-
-	// Tries to unmarshal the contents of the value into an `interface{}`,
-	// if it fails, then the contents aren't a JSON object.
-	if err := json.Unmarshal([]byte(strVal), &value); err != nil {
-		value = strVal
+	if state.Value.IsNull() {
+		strVal = state.ValueWO.ValueString()
 	}
 
 	return &models.KeystoreContents{
 		Secrets: map[string]models.KeystoreSecret{
 			secretName: {
 				AsFile: new(state.AsFile.ValueBool()),
-				Value:  value,
+				Value:  expandValue(strVal),
 			},
 		},
 	}
 }
+
+func expandValue(strVal string) any {
+	var value any
+
+	// Tries to unmarshal the contents of the value into an `interface{}`,
+	// if it fails, then the contents aren't a JSON object.
+	if err := json.Unmarshal([]byte(strVal), &value); err != nil {
+		value = strVal
+	}
+
+	return value
+}
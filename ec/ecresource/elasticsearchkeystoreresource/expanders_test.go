@@ -57,6 +57,26 @@ func Test_expandModel(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "parses the resource with a write-only value",
+			args: args{state: modelV0{
+				ID:             types.StringValue("some-random-id"),
+				DeploymentID:   types.StringValue(mock.ValidClusterID),
+				SettingName:    types.StringValue("my_secret"),
+				Value:          types.StringNull(),
+				ValueWO:        types.StringValue("supersecret"),
+				ValueWOVersion: types.Int64Value(1),
+				AsFile:         types.BoolValue(false),
+			}},
+			want: &models.KeystoreContents{
+				Secrets: map[string]models.KeystoreSecret{
+					"my_secret": {
+						AsFile: new(false),
+						Value:  "supersecret",
+					},
+				},
+			},
+		},
 		{
 			name: "parses the resource with a json formatted value",
 			args: args{state: modelV0{
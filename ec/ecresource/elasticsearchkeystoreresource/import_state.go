@@ -0,0 +1,48 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package elasticsearchkeystoreresource
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+var _ resource.ResourceWithImportState = &Resource{}
+
+// ImportState adopts an existing keystore setting, identified by
+// <deployment_id>/<setting_name>. Since the keystore API never returns
+// secret values, `value` is left empty until the next apply.
+func (r Resource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	deploymentID, settingName, ok := strings.Cut(request.ID, "/")
+
+	if !ok || deploymentID == "" || settingName == "" {
+		response.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: deployment_id/setting_name. Got: %q", request.ID),
+		)
+		return
+	}
+
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("id"), hashID(deploymentID, settingName))...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("deployment_id"), deploymentID)...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("setting_name"), settingName)...)
+}
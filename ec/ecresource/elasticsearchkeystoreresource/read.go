@@ -19,12 +19,15 @@ package elasticsearchkeystoreresource
 
 import (
 	"context"
+	"errors"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/elastic/cloud-sdk-go/pkg/api/deploymentapi/eskeystoreapi"
+	"github.com/elastic/cloud-sdk-go/pkg/client/deployments"
 	"github.com/elastic/cloud-sdk-go/pkg/models"
 )
 
@@ -62,6 +65,10 @@ func (r Resource) read(ctx context.Context, deploymentID string, state *modelV0)
 		DeploymentID: deploymentID,
 	})
 	if err != nil {
+		// The keystore is gone together with its deployment, so is the setting.
+		if keystoreNotFound(err) {
+			return false, diags
+		}
 		diags.AddError(err.Error(), err.Error())
 		return true, diags
 	}
@@ -77,11 +84,22 @@ func modelToState(ctx context.Context, res *models.KeystoreContents, state *mode
 	if secret, ok := res.Secrets[state.SettingName.ValueString()]; ok {
 		if secret.AsFile != nil {
 			state.AsFile = types.BoolValue(*secret.AsFile)
+		} else if state.AsFile.IsNull() {
+			state.AsFile = types.BoolValue(false)
 		}
 		return true, nil
 	}
 
 	// When the secret is not found in the returned map of secrets, the resource should be removed from state.
 	// Would only happen if secrets are removed from the underlying Deployment.
+	tflog.Warn(ctx, "keystore setting not found, removing it from state so that it's recreated", map[string]any{
+		"setting_name": state.SettingName.ValueString(),
+	})
 	return false, nil
 }
+
+func keystoreNotFound(err error) bool {
+	var keystoreNotFound *deployments.GetDeploymentEsResourceKeystoreNotFound
+	var deploymentNotFound *deployments.GetDeploymentNotFound
+	return errors.As(err, &keystoreNotFound) || errors.As(err, &deploymentNotFound)
+}
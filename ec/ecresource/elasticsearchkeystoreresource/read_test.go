@@ -0,0 +1,111 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package elasticsearchkeystoreresource
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/cloud-sdk-go/pkg/api/apierror"
+	"github.com/elastic/cloud-sdk-go/pkg/client/deployments"
+	"github.com/elastic/cloud-sdk-go/pkg/models"
+)
+
+func TestResource_ImportState(t *testing.T) {
+	ctx := context.Background()
+
+	var schemaResp resource.SchemaResponse
+	(&Resource{}).Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	newResponse := func() *resource.ImportStateResponse {
+		return &resource.ImportStateResponse{
+			State: tfsdk.State{
+				Schema: schemaResp.Schema,
+				Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+			},
+		}
+	}
+
+	t.Run("sets the deployment and setting name", func(t *testing.T) {
+		response := newResponse()
+		Resource{}.ImportState(ctx, resource.ImportStateRequest{ID: "deployment-id/s3.client.default.secret_key"}, response)
+		require.False(t, response.Diagnostics.HasError(), response.Diagnostics)
+
+		var state modelV0
+		require.False(t, response.State.Get(ctx, &state).HasError())
+		assert.Equal(t, hashID("deployment-id", "s3.client.default.secret_key"), state.ID.ValueString())
+		assert.Equal(t, "deployment-id", state.DeploymentID.ValueString())
+		assert.Equal(t, "s3.client.default.secret_key", state.SettingName.ValueString())
+		assert.True(t, state.Value.IsNull())
+	})
+
+	for _, id := range []string{"deployment-id", "/setting", "deployment-id/", ""} {
+		t.Run("rejects "+id, func(t *testing.T) {
+			response := newResponse()
+			Resource{}.ImportState(ctx, resource.ImportStateRequest{ID: id}, response)
+			require.True(t, response.Diagnostics.HasError())
+			assert.Equal(t, "Unexpected Import Identifier", response.Diagnostics[0].Summary())
+		})
+	}
+}
+
+func Test_modelToState(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("reads as_file from an existing setting", func(t *testing.T) {
+		state := modelV0{SettingName: types.StringValue("my_secret"), AsFile: types.BoolNull()}
+		found, diags := modelToState(ctx, &models.KeystoreContents{
+			Secrets: map[string]models.KeystoreSecret{"my_secret": {AsFile: new(true)}},
+		}, &state)
+		require.False(t, diags.HasError())
+		assert.True(t, found)
+		assert.Equal(t, types.BoolValue(true), state.AsFile)
+	})
+
+	t.Run("defaults as_file for an imported setting", func(t *testing.T) {
+		state := modelV0{SettingName: types.StringValue("my_secret"), AsFile: types.BoolNull()}
+		found, diags := modelToState(ctx, &models.KeystoreContents{
+			Secrets: map[string]models.KeystoreSecret{"my_secret": {}},
+		}, &state)
+		require.False(t, diags.HasError())
+		assert.True(t, found)
+		assert.Equal(t, types.BoolValue(false), state.AsFile)
+	})
+
+	t.Run("reports a setting removed out of band as not found", func(t *testing.T) {
+		state := modelV0{SettingName: types.StringValue("my_secret")}
+		found, diags := modelToState(ctx, &models.KeystoreContents{
+			Secrets: map[string]models.KeystoreSecret{"other_secret": {}},
+		}, &state)
+		require.False(t, diags.HasError())
+		assert.False(t, found)
+	})
+}
+
+func Test_keystoreNotFound(t *testing.T) {
+	assert.True(t, keystoreNotFound(apierror.Wrap(deployments.NewGetDeploymentEsResourceKeystoreNotFound())))
+	assert.True(t, keystoreNotFound(apierror.Wrap(deployments.NewGetDeploymentNotFound())))
+	assert.False(t, keystoreNotFound(apierror.Wrap(deployments.NewGetDeploymentEsResourceKeystoreInternalServerError())))
+}
@@ -20,11 +20,15 @@ package elasticsearchkeystoreresource
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/elastic/cloud-sdk-go/pkg/api"
@@ -65,16 +69,32 @@ func (r *Resource) Schema(_ context.Context, _ resource.SchemaRequest, resp *res
 				},
 			},
 			"setting_name": schema.StringAttribute{
-				Description: "Name for the keystore setting, if the setting already exists in the Elasticsearch cluster, it will be overridden.",
+				Description: "Name for the keystore setting, if the setting already exists in the Elasticsearch cluster, it will be overridden. The values of known secure settings, such as GCS credentials or S3 client keys, are validated at plan time.",
 				Required:    true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
 			"value": schema.StringAttribute{
-				Description: "Value of this setting. This can either be a string or a JSON object that is stored as a JSON string in the keystore.",
+				Description: "Value of this setting. This can either be a string or a JSON object that is stored as a JSON string in the keystore. Exactly one of `value` or `value_wo` must be set.",
 				Sensitive:   true,
-				Required:    true,
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("value_wo")),
+				},
+			},
+			"value_wo": schema.StringAttribute{
+				Description: "Write-only value of this setting, which is never stored in the Terraform state or plan. This can either be a string or a JSON object that is stored as a JSON string in the keystore. Since the value isn't stored, changes to it are only applied when `value_wo_version` changes. Requires Terraform 1.11 or later.",
+				Sensitive:   true,
+				Optional:    true,
+				WriteOnly:   true,
+			},
+			"value_wo_version": schema.Int64Attribute{
+				Description: "Version of the write-only `value_wo`. Change this value to push an updated `value_wo` to the keystore.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("value_wo")),
+				},
 			},
 			"as_file": schema.BoolAttribute{
 				Description: "Indicates the the remote keystore setting should be stored as a file. The default is false, which stores the keystore setting as string when value is a plain string.",
@@ -115,9 +135,11 @@ func resourceReady(r Resource, dg *diag.Diagnostics) bool {
 }
 
 type modelV0 struct {
-	ID           types.String `tfsdk:"id"`
-	DeploymentID types.String `tfsdk:"deployment_id"`
-	SettingName  types.String `tfsdk:"setting_name"`
-	Value        types.String `tfsdk:"value"`
-	AsFile       types.Bool   `tfsdk:"as_file"`
+	ID             types.String `tfsdk:"id"`
+	DeploymentID   types.String `tfsdk:"deployment_id"`
+	SettingName    types.String `tfsdk:"setting_name"`
+	Value          types.String `tfsdk:"value"`
+	ValueWO        types.String `tfsdk:"value_wo"`
+	ValueWOVersion types.Int64  `tfsdk:"value_wo_version"`
+	AsFile         types.Bool   `tfsdk:"as_file"`
 }
@@ -0,0 +1,77 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package elasticsearchkeystoreresource
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/elastic/cloud-sdk-go/pkg/api/deploymentapi/eskeystoreapi"
+	"github.com/elastic/cloud-sdk-go/pkg/models"
+)
+
+func (r SettingsResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	if !settingsResourceReady(r, &response.Diagnostics) {
+		return
+	}
+
+	var newState, config settingsModelV0
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &newState)...)
+	response.Diagnostics.Append(request.Config.Get(ctx, &config)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	withWriteOnlyValues(&newState, config)
+
+	if err := r.apply(newState, nil); err != nil {
+		response.Diagnostics.AddError(err.Error(), err.Error())
+		return
+	}
+
+	newState.ID = newState.DeploymentID
+
+	// Finally, set the state
+	response.Diagnostics.Append(response.State.Set(ctx, newState)...)
+}
+
+// apply writes the planned settings to the keystore in a single request,
+// removing the settings that are no longer managed.
+func (r SettingsResource) apply(plan settingsModelV0, state *settingsModelV0) error {
+	var current *models.KeystoreContents
+	if plan.Exclusive.ValueBool() {
+		res, err := eskeystoreapi.Get(eskeystoreapi.GetParams{
+			API:          r.client,
+			DeploymentID: plan.DeploymentID.ValueString(),
+		})
+		if err != nil {
+			return err
+		}
+		current = res
+	}
+
+	_, err := eskeystoreapi.Update(eskeystoreapi.UpdateParams{
+		API:          r.client,
+		DeploymentID: plan.DeploymentID.ValueString(),
+		Contents:     expandSettings(plan, settingsToRemove(plan, state, current)),
+	})
+
+	return err
+}
@@ -0,0 +1,131 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package elasticsearchkeystoreresource
+
+import (
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/elastic/cloud-sdk-go/pkg/models"
+)
+
+// expandSettings returns the keystore contents which write all the settings
+// in the plan, and remove the settings listed in remove.
+func expandSettings(plan settingsModelV0, remove []string) *models.KeystoreContents {
+	secrets := make(map[string]models.KeystoreSecret, len(plan.Settings)+len(remove))
+
+	// Since we're using the Update API (PATCH method), we need to leave the
+	// value unspecified for the keystore setting to be unset.
+	for _, name := range remove {
+		secrets[name] = models.KeystoreSecret{}
+	}
+
+	for name, setting := range plan.Settings {
+		strVal := setting.Value.ValueString()
+		if setting.Value.IsNull() {
+			strVal = setting.ValueWO.ValueString()
+		}
+
+		secrets[name] = models.KeystoreSecret{
+			AsFile: new(setting.AsFile.ValueBool()),
+			Value:  expandValue(strVal),
+		}
+	}
+
+	return &models.KeystoreContents{Secrets: secrets}
+}
+
+// settingsToRemove returns the names of the settings that are in the state
+// or, in exclusive mode, in the current keystore contents but not in the plan.
+func settingsToRemove(plan settingsModelV0, state *settingsModelV0, current *models.KeystoreContents) []string {
+	var remove []string
+
+	if state != nil {
+		for name := range state.Settings {
+			if _, ok := plan.Settings[name]; !ok {
+				remove = append(remove, name)
+			}
+		}
+	}
+
+	if plan.Exclusive.ValueBool() && current != nil {
+		for name := range current.Secrets {
+			if _, ok := plan.Settings[name]; !ok && !slices.Contains(remove, name) {
+				remove = append(remove, name)
+			}
+		}
+	}
+
+	slices.Sort(remove)
+
+	return remove
+}
+
+// withWriteOnlyValues copies the write-only values from the configuration into
+// the plan, since Terraform never includes write-only values in the plan.
+func withWriteOnlyValues(plan *settingsModelV0, config settingsModelV0) {
+	for name, setting := range plan.Settings {
+		if cfg, ok := config.Settings[name]; ok {
+			setting.ValueWO = cfg.ValueWO
+			plan.Settings[name] = setting
+		}
+	}
+}
+
+// settingsToState refreshes the state from the current keystore contents.
+// Managed settings that were removed out of band are dropped from the state so
+// that they are written again, and in exclusive mode unmanaged settings are
+// added to it so that they show up as drift.
+func settingsToState(res *models.KeystoreContents, state *settingsModelV0) {
+	for name, setting := range state.Settings {
+		secret, ok := res.Secrets[name]
+		if !ok {
+			delete(state.Settings, name)
+			continue
+		}
+
+		if secret.AsFile != nil {
+			setting.AsFile = types.BoolValue(*secret.AsFile)
+		} else if setting.AsFile.IsNull() {
+			setting.AsFile = types.BoolValue(false)
+		}
+		state.Settings[name] = setting
+	}
+
+	if !state.Exclusive.ValueBool() {
+		return
+	}
+
+	for name, secret := range res.Secrets {
+		if _, ok := state.Settings[name]; ok {
+			continue
+		}
+
+		if state.Settings == nil {
+			state.Settings = make(map[string]settingModelV0)
+		}
+
+		state.Settings[name] = settingModelV0{
+			Value:          types.StringNull(),
+			ValueWO:        types.StringNull(),
+			ValueWOVersion: types.Int64Null(),
+			AsFile:         types.BoolValue(secret.AsFile != nil && *secret.AsFile),
+		}
+	}
+}
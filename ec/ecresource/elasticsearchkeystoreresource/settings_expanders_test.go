@@ -0,0 +1,137 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package elasticsearchkeystoreresource
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/cloud-sdk-go/pkg/models"
+)
+
+func Test_expandSettings(t *testing.T) {
+	plan := settingsModelV0{
+		Settings: map[string]settingModelV0{
+			"s3.client.default.access_key": {Value: types.StringValue("access"), ValueWO: types.StringNull(), AsFile: types.BoolValue(false)},
+			"s3.client.default.secret_key": {Value: types.StringNull(), ValueWO: types.StringValue("secret"), AsFile: types.BoolValue(false)},
+			"gcs.client.default.credentials_file": {
+				Value:   types.StringValue(`{"type": "service_account"}`),
+				ValueWO: types.StringNull(),
+				AsFile:  types.BoolValue(true),
+			},
+		},
+	}
+
+	assert.Equal(t, &models.KeystoreContents{
+		Secrets: map[string]models.KeystoreSecret{
+			"s3.client.default.access_key":        {AsFile: new(false), Value: "access"},
+			"s3.client.default.secret_key":        {AsFile: new(false), Value: "secret"},
+			"gcs.client.default.credentials_file": {AsFile: new(true), Value: map[string]any{"type": "service_account"}},
+			"old.setting":                         {},
+		},
+	}, expandSettings(plan, []string{"old.setting"}))
+}
+
+func Test_settingsToRemove(t *testing.T) {
+	plan := settingsModelV0{
+		Exclusive: types.BoolValue(false),
+		Settings:  map[string]settingModelV0{"kept": {}},
+	}
+	state := &settingsModelV0{
+		Settings: map[string]settingModelV0{"kept": {}, "removed": {}},
+	}
+	current := &models.KeystoreContents{
+		Secrets: map[string]models.KeystoreSecret{"kept": {}, "removed": {}, "unmanaged": {}},
+	}
+
+	t.Run("removes settings dropped from the plan", func(t *testing.T) {
+		assert.Equal(t, []string{"removed"}, settingsToRemove(plan, state, current))
+	})
+
+	t.Run("removes unmanaged settings in exclusive mode", func(t *testing.T) {
+		exclusive := plan
+		exclusive.Exclusive = types.BoolValue(true)
+		assert.Equal(t, []string{"removed", "unmanaged"}, settingsToRemove(exclusive, state, current))
+	})
+
+	t.Run("removes nothing on create", func(t *testing.T) {
+		assert.Empty(t, settingsToRemove(plan, nil, nil))
+	})
+}
+
+func Test_withWriteOnlyValues(t *testing.T) {
+	plan := settingsModelV0{
+		Settings: map[string]settingModelV0{
+			"plain":      {Value: types.StringValue("value"), ValueWO: types.StringNull()},
+			"write_only": {Value: types.StringNull(), ValueWO: types.StringNull(), ValueWOVersion: types.Int64Value(1)},
+		},
+	}
+	config := settingsModelV0{
+		Settings: map[string]settingModelV0{
+			"plain":      {Value: types.StringValue("value"), ValueWO: types.StringNull()},
+			"write_only": {Value: types.StringNull(), ValueWO: types.StringValue("secret"), ValueWOVersion: types.Int64Value(1)},
+		},
+	}
+
+	withWriteOnlyValues(&plan, config)
+
+	assert.Equal(t, types.StringNull(), plan.Settings["plain"].ValueWO)
+	assert.Equal(t, types.StringValue("secret"), plan.Settings["write_only"].ValueWO)
+}
+
+func Test_settingsToState(t *testing.T) {
+	res := &models.KeystoreContents{
+		Secrets: map[string]models.KeystoreSecret{
+			"managed":   {AsFile: new(true)},
+			"unmanaged": {},
+		},
+	}
+	newState := func(exclusive bool) *settingsModelV0 {
+		return &settingsModelV0{
+			Exclusive: types.BoolValue(exclusive),
+			Settings: map[string]settingModelV0{
+				"managed": {Value: types.StringValue("value"), AsFile: types.BoolValue(false)},
+				"deleted": {Value: types.StringValue("value"), AsFile: types.BoolValue(false)},
+			},
+		}
+	}
+
+	t.Run("drops settings removed out of band", func(t *testing.T) {
+		state := newState(false)
+		settingsToState(res, state)
+		assert.Equal(t, map[string]settingModelV0{
+			"managed": {Value: types.StringValue("value"), AsFile: types.BoolValue(true)},
+		}, state.Settings)
+	})
+
+	t.Run("reports unmanaged settings in exclusive mode", func(t *testing.T) {
+		state := newState(true)
+		settingsToState(res, state)
+		assert.Equal(t, map[string]settingModelV0{
+			"managed": {Value: types.StringValue("value"), AsFile: types.BoolValue(true)},
+			"unmanaged": {
+				Value:          types.StringNull(),
+				ValueWO:        types.StringNull(),
+				ValueWOVersion: types.Int64Null(),
+				AsFile:         types.BoolValue(false),
+			},
+		}, state.Settings)
+	})
+}
@@ -0,0 +1,73 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package elasticsearchkeystoreresource
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/elastic/cloud-sdk-go/pkg/api/deploymentapi/eskeystoreapi"
+)
+
+func (r SettingsResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	if !settingsResourceReady(r, &response.Diagnostics) {
+		return
+	}
+
+	var newState settingsModelV0
+
+	diags := request.State.Get(ctx, &newState)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	found, diags := r.read(&newState)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	// Finally, set the state
+	response.Diagnostics.Append(response.State.Set(ctx, newState)...)
+}
+
+func (r SettingsResource) read(state *settingsModelV0) (found bool, diags diag.Diagnostics) {
+	res, err := eskeystoreapi.Get(eskeystoreapi.GetParams{
+		API:          r.client,
+		DeploymentID: state.DeploymentID.ValueString(),
+	})
+	if err != nil {
+		// The keystore is gone together with its deployment, so are the settings.
+		if keystoreNotFound(err) {
+			return false, diags
+		}
+		diags.AddError(err.Error(), err.Error())
+		return true, diags
+	}
+
+	settingsToState(res, state)
+
+	return true, diags
+}
@@ -0,0 +1,215 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package elasticsearchkeystoreresource
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/cloud-sdk-go/pkg/api"
+	"github.com/elastic/cloud-sdk-go/pkg/api/mock"
+)
+
+const settingsDeploymentID = "0a592ab2c5baf0fa95c77ac62135782e"
+
+func TestSettingsResource_Create(t *testing.T) {
+	ctx := context.Background()
+	sch := settingsSchema(t)
+
+	plan := settingsModel(true, map[string]settingModelV0{
+		"managed": setting("value"),
+	})
+
+	t.Run("removes unmanaged settings in exclusive mode in the same update", func(t *testing.T) {
+		r := SettingsResource{client: api.NewMock(
+			settingsReadDeployment(),
+			settingsReadKeystore(`{"secrets": {"managed": {}, "unmanaged": {}}}`),
+			settingsReadDeployment(),
+			settingsUpdateKeystore(`{"secrets":{"managed":{"as_file":false,"value":"value"},"unmanaged":{}}}`),
+		)}
+
+		response := &resource.CreateResponse{State: tfsdk.State{Schema: sch, Raw: tftypes.NewValue(sch.Type().TerraformType(ctx), nil)}}
+		r.Create(ctx, resource.CreateRequest{
+			Plan:   tfsdk.Plan{Schema: sch, Raw: settingsRaw(t, sch, plan)},
+			Config: tfsdk.Config{Schema: sch, Raw: settingsRaw(t, sch, plan)},
+		}, response)
+		require.False(t, response.Diagnostics.HasError(), response.Diagnostics)
+
+		var state settingsModelV0
+		require.False(t, response.State.Get(ctx, &state).HasError())
+		assert.Equal(t, types.StringValue(settingsDeploymentID), state.ID)
+		assert.Equal(t, plan.Settings, state.Settings)
+	})
+}
+
+func TestSettingsResource_Update(t *testing.T) {
+	ctx := context.Background()
+	sch := settingsSchema(t)
+
+	state := settingsModel(false, map[string]settingModelV0{
+		"kept":    setting("old"),
+		"removed": setting("value"),
+	})
+	plan := settingsModel(false, map[string]settingModelV0{
+		"kept":  setting("new"),
+		"added": setting("value"),
+	})
+
+	t.Run("writes and removes the settings in a single update", func(t *testing.T) {
+		r := SettingsResource{client: api.NewMock(
+			settingsReadDeployment(),
+			settingsUpdateKeystore(`{"secrets":{"added":{"as_file":false,"value":"value"},"kept":{"as_file":false,"value":"new"},"removed":{}}}`),
+		)}
+
+		response := &resource.UpdateResponse{State: tfsdk.State{Schema: sch, Raw: settingsRaw(t, sch, state)}}
+		r.Update(ctx, resource.UpdateRequest{
+			Plan:   tfsdk.Plan{Schema: sch, Raw: settingsRaw(t, sch, plan)},
+			Config: tfsdk.Config{Schema: sch, Raw: settingsRaw(t, sch, plan)},
+			State:  tfsdk.State{Schema: sch, Raw: settingsRaw(t, sch, state)},
+		}, response)
+		require.False(t, response.Diagnostics.HasError(), response.Diagnostics)
+
+		var got settingsModelV0
+		require.False(t, response.State.Get(ctx, &got).HasError())
+		assert.Equal(t, plan.Settings, got.Settings)
+	})
+}
+
+func TestSettingsResource_Read(t *testing.T) {
+	ctx := context.Background()
+	sch := settingsSchema(t)
+
+	state := settingsModel(true, map[string]settingModelV0{
+		"managed": setting("value"),
+	})
+
+	t.Run("shows unmanaged settings as drift in exclusive mode", func(t *testing.T) {
+		r := SettingsResource{client: api.NewMock(
+			settingsReadDeployment(),
+			settingsReadKeystore(`{"secrets": {"managed": {}, "unmanaged": {"as_file": true}}}`),
+		)}
+
+		response := &resource.ReadResponse{State: tfsdk.State{Schema: sch, Raw: settingsRaw(t, sch, state)}}
+		r.Read(ctx, resource.ReadRequest{State: tfsdk.State{Schema: sch, Raw: settingsRaw(t, sch, state)}}, response)
+		require.False(t, response.Diagnostics.HasError(), response.Diagnostics)
+
+		var got settingsModelV0
+		require.False(t, response.State.Get(ctx, &got).HasError())
+		assert.Equal(t, map[string]settingModelV0{
+			"managed": setting("value"),
+			"unmanaged": {
+				Value:          types.StringNull(),
+				ValueWO:        types.StringNull(),
+				ValueWOVersion: types.Int64Null(),
+				AsFile:         types.BoolValue(true),
+			},
+		}, got.Settings)
+	})
+}
+
+func settingsSchema(t *testing.T) schema.Schema {
+	t.Helper()
+	var response resource.SchemaResponse
+	(&SettingsResource{}).Schema(context.Background(), resource.SchemaRequest{}, &response)
+	require.False(t, response.Diagnostics.HasError())
+	return response.Schema
+}
+
+func settingsModel(exclusive bool, settings map[string]settingModelV0) settingsModelV0 {
+	return settingsModelV0{
+		ID:           types.StringValue(settingsDeploymentID),
+		DeploymentID: types.StringValue(settingsDeploymentID),
+		Exclusive:    types.BoolValue(exclusive),
+		Settings:     settings,
+	}
+}
+
+func setting(value string) settingModelV0 {
+	return settingModelV0{
+		Value:          types.StringValue(value),
+		ValueWO:        types.StringNull(),
+		ValueWOVersion: types.Int64Null(),
+		AsFile:         types.BoolValue(false),
+	}
+}
+
+func settingsRaw(t *testing.T, sch schema.Schema, model settingsModelV0) tftypes.Value {
+	t.Helper()
+	state := tfsdk.State{Schema: sch}
+	require.False(t, state.Set(context.Background(), model).HasError())
+	return state.Raw
+}
+
+func settingsReadDeployment() mock.Response {
+	return mock.New200ResponseAssertion(
+		&mock.RequestAssertion{
+			Host:   api.DefaultMockHost,
+			Header: api.DefaultReadMockHeaders,
+			Method: "GET",
+			Path:   "/api/v1/deployments/" + settingsDeploymentID,
+			Query: url.Values{
+				"convert_legacy_plans": []string{"false"},
+				"show_metadata":        []string{"false"},
+				"show_plan_defaults":   []string{"false"},
+				"show_plan_history":    []string{"false"},
+				"show_plan_logs":       []string{"false"},
+				"show_plans":           []string{"false"},
+				"show_settings":        []string{"false"},
+				"show_system_alerts":   []string{"5"},
+			},
+		},
+		mock.NewStringBody(`{"id": "`+settingsDeploymentID+`", "resources": {"elasticsearch": [{"ref_id": "main-elasticsearch"}]}}`),
+	)
+}
+
+func settingsReadKeystore(body string) mock.Response {
+	return mock.New200ResponseAssertion(
+		&mock.RequestAssertion{
+			Host:   api.DefaultMockHost,
+			Header: api.DefaultReadMockHeaders,
+			Method: "GET",
+			Path:   "/api/v1/deployments/" + settingsDeploymentID + "/elasticsearch/main-elasticsearch/keystore",
+			Query:  url.Values{},
+		},
+		mock.NewStringBody(body),
+	)
+}
+
+// settingsUpdateKeystore expects a single keystore update with the given body.
+func settingsUpdateKeystore(body string) mock.Response {
+	return mock.New200ResponseAssertion(
+		&mock.RequestAssertion{
+			Host:   api.DefaultMockHost,
+			Header: api.DefaultWriteMockHeaders,
+			Method: "PATCH",
+			Path:   "/api/v1/deployments/" + settingsDeploymentID + "/elasticsearch/main-elasticsearch/keystore",
+			Query:  url.Values{},
+			Body:   mock.NewStringBody(body + "\n"),
+		},
+		mock.NewStringBody(`{}`),
+	)
+}
@@ -0,0 +1,149 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package elasticsearchkeystoreresource
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/elastic/cloud-sdk-go/pkg/api"
+	"github.com/elastic/terraform-provider-ec/ec/internal"
+	"github.com/elastic/terraform-provider-ec/ec/internal/planmodifiers"
+	"github.com/elastic/terraform-provider-ec/ec/internal/validators"
+)
+
+var _ resource.Resource = &SettingsResource{}
+var _ resource.ResourceWithConfigure = &SettingsResource{}
+
+func (r *SettingsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `Provides an Elastic Cloud Deployment Elasticsearch keystore settings resource, which allows you to manage multiple Elasticsearch keystore settings at once.
+
+  All the settings of this resource are written to the keystore in a single request, so that they are applied as a single change to the Elasticsearch cluster. When ` + "`exclusive`" + ` is set, any keystore setting that isn't defined in this resource is removed from the keystore.
+
+  ~> **Note on Elastic keystore settings** This resource offers weaker consistency guarantees and will not detect and update keystore setting values that have been modified outside of the scope of Terraform, usually referred to as _drift_. Settings removed from the keystore outside of Terraform are detected and written again on the next apply.
+
+  Before you create Elasticsearch keystore settings, check the [official Elasticsearch keystore documentation](https://www.elastic.co/guide/en/elasticsearch/reference/master/elasticsearch-keystore.html) and the [Elastic Cloud specific documentation](https://www.elastic.co/guide/en/cloud/current/ec-configuring-keystore.html).`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier of this resource.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"deployment_id": schema.StringAttribute{
+				Description: `Deployment ID of the Deployment that holds the Elasticsearch cluster where the keystore settings will be written to.`,
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"exclusive": schema.BoolAttribute{
+				Description: "If true, keystore settings that are not defined in `settings` are removed from the keystore, and reported as drift when added outside of Terraform. The default is false, which leaves settings not managed by this resource untouched.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					planmodifiers.BoolDefaultValue(false),
+				},
+			},
+			"settings": schema.MapNestedAttribute{
+				Description: "Keystore settings keyed by the setting name. If a setting already exists in the Elasticsearch cluster, it will be overridden. The values of known secure settings, such as GCS credentials or S3 client keys, are validated at plan time.",
+				Required:    true,
+				Validators: []validator.Map{
+					validators.KeystoreSettings(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"value": schema.StringAttribute{
+							Description: "Value of this setting. This can either be a string or a JSON object that is stored as a JSON string in the keystore. Exactly one of `value` or `value_wo` must be set.",
+							Optional:    true,
+							Sensitive:   true,
+							Validators: []validator.String{
+								stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("value_wo")),
+							},
+						},
+						"value_wo": schema.StringAttribute{
+							Description: "Write-only value of this setting, which is never stored in the Terraform state or plan. Since the value isn't stored, changes to it are only applied when `value_wo_version` changes. Requires Terraform 1.11 or later.",
+							Optional:    true,
+							Sensitive:   true,
+							WriteOnly:   true,
+						},
+						"value_wo_version": schema.Int64Attribute{
+							Description: "Version of the write-only `value_wo`. Change this value to push an updated `value_wo` to the keystore.",
+							Optional:    true,
+							Validators: []validator.Int64{
+								int64validator.AlsoRequires(path.MatchRelative().AtParent().AtName("value_wo")),
+							},
+						},
+						"as_file": schema.BoolAttribute{
+							Description: "Indicates the the remote keystore setting should be stored as a file. The default is false, which stores the keystore setting as string when value is a plain string.",
+							Optional:    true,
+							Computed:    true,
+							PlanModifiers: []planmodifier.Bool{
+								planmodifiers.BoolDefaultValue(false),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type SettingsResource struct {
+	client *api.API
+}
+
+func (r *SettingsResource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	clients, diags := internal.ConvertProviderData(request.ProviderData)
+	response.Diagnostics.Append(diags...)
+	r.client = clients.Stateful
+}
+
+func (r *SettingsResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_deployment_elasticsearch_keystore_settings"
+}
+
+func settingsResourceReady(r SettingsResource, dg *diag.Diagnostics) bool {
+	return resourceReady(Resource{client: r.client}, dg)
+}
+
+type settingsModelV0 struct {
+	ID           types.String              `tfsdk:"id"`
+	DeploymentID types.String              `tfsdk:"deployment_id"`
+	Exclusive    types.Bool                `tfsdk:"exclusive"`
+	Settings     map[string]settingModelV0 `tfsdk:"settings"`
+}
+
+type settingModelV0 struct {
+	Value          types.String `tfsdk:"value"`
+	ValueWO        types.String `tfsdk:"value_wo"`
+	ValueWOVersion types.Int64  `tfsdk:"value_wo_version"`
+	AsFile         types.Bool   `tfsdk:"as_file"`
+}
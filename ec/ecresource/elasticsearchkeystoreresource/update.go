@@ -20,6 +20,7 @@ package elasticsearchkeystoreresource
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 
 	"github.com/elastic/cloud-sdk-go/pkg/api/deploymentapi/eskeystoreapi"
@@ -39,6 +40,12 @@ func (r Resource) Update(ctx context.Context, request resource.UpdateRequest, re
 		return
 	}
 
+	// Write-only values are only available in the configuration.
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root("value_wo"), &newState.ValueWO)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	_, err := eskeystoreapi.Update(eskeystoreapi.UpdateParams{
 		API:          r.client,
 		DeploymentID: newState.DeploymentID.ValueString(),
@@ -0,0 +1,53 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package elasticsearchkeystoreresource
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/elastic/terraform-provider-ec/ec/internal/validators"
+)
+
+var _ resource.ResourceWithValidateConfig = &Resource{}
+
+// ValidateConfig validates the value of known secure settings, such as GCS
+// credentials or S3 client keys, at plan time.
+func (r *Resource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var config modelV0
+	response.Diagnostics.Append(request.Config.Get(ctx, &config)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Skip validation if the setting name is unknown (e.g., during plan with variables)
+	if config.SettingName.IsNull() || config.SettingName.IsUnknown() {
+		return
+	}
+
+	valuePath, value := path.Root("value"), config.Value
+	if value.IsNull() {
+		valuePath, value = path.Root("value_wo"), config.ValueWO
+	}
+
+	response.Diagnostics.Append(validators.ValidateKeystoreSetting(
+		path.Root("setting_name"), valuePath, config.SettingName.ValueString(), value,
+	)...)
+}
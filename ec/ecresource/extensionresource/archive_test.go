@@ -0,0 +1,99 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package extensionresource
+
+import (
+	"archive/zip"
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+	for name, contents := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(contents), 0o600))
+	}
+}
+
+func Test_archiveDirectory(t *testing.T) {
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{
+		"scripts/my-script.painless":   "return 1;",
+		"dictionaries/synonyms.txt":    "tv, television",
+		"dictionaries/.DS_Store":       "ignored",
+		".git/config":                  "ignored",
+		"dictionaries/stopwords_a.txt": "a\nan\nthe",
+	})
+
+	contents, err := archiveDirectory(dir)
+	require.NoError(t, err)
+
+	reader, err := zip.NewReader(bytes.NewReader(contents), int64(len(contents)))
+	require.NoError(t, err)
+
+	var names []string
+	for _, f := range reader.File {
+		names = append(names, f.Name)
+		assert.True(t, f.Modified.Equal(archiveModTime), f.Name)
+	}
+	assert.Equal(t, []string{
+		"dictionaries/stopwords_a.txt",
+		"dictionaries/synonyms.txt",
+		"scripts/my-script.painless",
+	}, names)
+
+	t.Run("is deterministic", func(t *testing.T) {
+		later := time.Now().Add(time.Hour)
+		require.NoError(t, os.Chtimes(filepath.Join(dir, "dictionaries", "synonyms.txt"), later, later))
+
+		again, err := archiveDirectory(dir)
+		require.NoError(t, err)
+		assert.Equal(t, hashContents(contents), hashContents(again))
+	})
+
+	t.Run("changes when the contents change", func(t *testing.T) {
+		writeFiles(t, dir, map[string]string{"dictionaries/synonyms.txt": "tv, television, telly"})
+
+		changed, err := archiveDirectory(dir)
+		require.NoError(t, err)
+		assert.NotEqual(t, hashContents(contents), hashContents(changed))
+	})
+
+	t.Run("fails for a missing directory", func(t *testing.T) {
+		_, err := archiveDirectory(filepath.Join(dir, "missing"))
+		assert.Error(t, err)
+	})
+
+	t.Run("fails for a file", func(t *testing.T) {
+		_, err := archiveDirectory(filepath.Join(dir, "scripts", "my-script.painless"))
+		assert.ErrorContains(t, err, "is not a directory")
+	})
+}
+
+func Test_hashContents(t *testing.T) {
+	// echo -n "hello" | sha256sum
+	assert.Equal(t, "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824", hashContents([]byte("hello")))
+}
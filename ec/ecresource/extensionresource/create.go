@@ -56,11 +56,17 @@ func (r *Resource) Create(ctx context.Context, request resource.CreateRequest, r
 
 	newState.ID = types.StringValue(*model.ID)
 
-	if !newState.FilePath.IsNull() && newState.FilePath.ValueString() != "" {
-		response.Diagnostics.Append(r.uploadExtension(newState)...)
+	if hasExtensionFile(newState) {
+		fileHash, diags := r.uploadExtension(ctx, newState)
+		response.Diagnostics.Append(diags...)
 		if response.Diagnostics.HasError() {
 			return
 		}
+
+		// The hash couldn't be computed at plan time, keep the one of the uploaded file.
+		if newState.FileHash.IsUnknown() {
+			newState.FileHash = types.StringValue(fileHash)
+		}
 	}
 
 	found, diags := r.read(newState.ID.ValueString(), &newState)
@@ -0,0 +1,132 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package extensionresource
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.ResourceWithModifyPlan = &Resource{}
+
+// ModifyPlan computes the hash of the file at `file_path`, or of the archive
+// built from `source_dir`, so that the extension is only uploaded again when
+// its contents change.
+func (r *Resource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	// Nothing to do on destroy.
+	if request.Plan.Raw.IsNull() {
+		return
+	}
+
+	var config modelV0
+	response.Diagnostics.Append(request.Config.Get(ctx, &config)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// The hash is set explicitly, keep it as is.
+	if !config.FileHash.IsNull() {
+		return
+	}
+
+	var state *modelV0
+	if !request.State.Raw.IsNull() {
+		state = &modelV0{}
+		response.Diagnostics.Append(request.State.Get(ctx, state)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	var fileHash types.String
+	var fileSize int64
+	switch {
+	case config.SourceDir.IsUnknown() || config.FilePath.IsUnknown():
+		fileHash = types.StringUnknown()
+	case !config.SourceDir.IsNull():
+		contents, err := archiveDirectory(config.SourceDir.ValueString())
+		if err != nil {
+			response.Diagnostics.AddAttributeError(
+				path.Root("source_dir"),
+				"Failed to archive the extension source directory",
+				err.Error(),
+			)
+			return
+		}
+		fileHash = types.StringValue(hashContents(contents))
+		fileSize = int64(len(contents))
+	case !config.FilePath.IsNull():
+		hash, size, err := hashExtensionFile(config.FilePath.ValueString())
+		switch {
+		case errors.Is(err, fs.ErrNotExist):
+			// The file may be created during the apply, hash it on upload.
+			fileHash = types.StringUnknown()
+		case err != nil:
+			response.Diagnostics.AddAttributeError(
+				path.Root("file_path"),
+				"Failed to read the extension file",
+				err.Error(),
+			)
+			return
+		default:
+			fileHash = types.StringValue(hash)
+			fileSize = size
+		}
+	default:
+		fileHash = types.StringNull()
+	}
+
+	response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("file_hash"), fileHash)...)
+
+	if state == nil || fileHash.IsNull() || fileHash.Equal(state.FileHash) {
+		return
+	}
+
+	// The extension was uploaded before its hash was tracked. Adopt the hash
+	// without uploading the file again, unless its size differs from the one
+	// of the uploaded file, which Read refreshes from the API.
+	if state.FileHash.IsNull() && !fileHash.IsUnknown() && state.Size.Equal(types.Int64Value(fileSize)) {
+		return
+	}
+
+	// The extension file will be uploaded again, so the file metadata will change.
+	response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("url"), types.StringUnknown())...)
+	response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("last_modified"), types.StringUnknown())...)
+	response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("size"), types.Int64Unknown())...)
+}
+
+// hashExtensionFile returns the hash and the size of the file at filePath.
+func hashExtensionFile(filePath string) (string, int64, error) {
+	hash, err := hashFile(filePath)
+	if err != nil {
+		return "", 0, err
+	}
+
+	info, err := os.Stat(filePath)
+	if err != nil {
+		return "", 0, err
+	}
+
+	return hash, info.Size(), nil
+}
@@ -0,0 +1,202 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package extensionresource
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResource_ModifyPlan(t *testing.T) {
+	ctx := context.Background()
+
+	var schemaResp resource.SchemaResponse
+	(&Resource{}).Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	sch := schemaResp.Schema
+
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{"dictionaries/synonyms.txt": "tv, television"})
+	contents, err := archiveDirectory(dir)
+	require.NoError(t, err)
+	dirHash := hashContents(contents)
+
+	baseModel := func() modelV0 {
+		return modelV0{
+			ID:            types.StringValue("extension-id"),
+			Name:          types.StringValue("my_extension"),
+			Description:   types.StringValue(""),
+			ExtensionType: types.StringValue("bundle"),
+			Version:       types.StringValue("*"),
+			DownloadURL:   types.StringValue(""),
+			FilePath:      types.StringNull(),
+			FileHash:      types.StringNull(),
+			SourceDir:     types.StringValue(dir),
+			URL:           types.StringValue("repo://1234"),
+			LastModified:  types.StringValue("2024-01-01"),
+			Size:          types.Int64Value(100),
+		}
+	}
+
+	run := func(t *testing.T, config, plan modelV0, state *modelV0) (modelV0, *resource.ModifyPlanResponse) {
+		req := resource.ModifyPlanRequest{
+			Config: tfsdk.Config{Schema: sch},
+			Plan:   tfsdk.Plan{Schema: sch},
+			State:  tfsdk.State{Schema: sch, Raw: tftypes.NewValue(sch.Type().TerraformType(ctx), nil)},
+		}
+		configState := tfsdk.State{Schema: sch}
+		require.False(t, configState.Set(ctx, config).HasError())
+		req.Config.Raw = configState.Raw
+		require.False(t, req.Plan.Set(ctx, plan).HasError())
+		if state != nil {
+			require.False(t, req.State.Set(ctx, state).HasError())
+		}
+
+		resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+		(&Resource{}).ModifyPlan(ctx, req, resp)
+
+		var got modelV0
+		require.False(t, resp.Plan.Get(ctx, &got).HasError())
+		return got, resp
+	}
+
+	t.Run("computes the hash of source_dir", func(t *testing.T) {
+		got, resp := run(t, baseModel(), baseModel(), nil)
+		require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+		assert.Equal(t, types.StringValue(dirHash), got.FileHash)
+	})
+
+	t.Run("keeps the file metadata when the contents are unchanged", func(t *testing.T) {
+		state := baseModel()
+		state.FileHash = types.StringValue(dirHash)
+
+		got, resp := run(t, baseModel(), state, &state)
+		require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+		assert.Equal(t, state, got)
+	})
+
+	t.Run("plans a new upload when the contents change", func(t *testing.T) {
+		state := baseModel()
+		state.FileHash = types.StringValue("previous-hash")
+
+		got, resp := run(t, baseModel(), state, &state)
+		require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+		assert.Equal(t, types.StringValue(dirHash), got.FileHash)
+		assert.True(t, got.URL.IsUnknown())
+		assert.True(t, got.LastModified.IsUnknown())
+		assert.True(t, got.Size.IsUnknown())
+	})
+
+	t.Run("keeps a configured file_hash", func(t *testing.T) {
+		config := baseModel()
+		config.SourceDir = types.StringNull()
+		config.FilePath = types.StringValue("extension.zip")
+		config.FileHash = types.StringValue("configured-hash")
+
+		got, resp := run(t, config, config, nil)
+		require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+		assert.Equal(t, types.StringValue("configured-hash"), got.FileHash)
+	})
+
+	t.Run("computes the hash of file_path", func(t *testing.T) {
+		filePath := filepath.Join(t.TempDir(), "extension.zip")
+		require.NoError(t, os.WriteFile(filePath, contents, 0o644))
+
+		config := baseModel()
+		config.SourceDir = types.StringNull()
+		config.FilePath = types.StringValue(filePath)
+
+		got, resp := run(t, config, config, nil)
+		require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+		assert.Equal(t, types.StringValue(dirHash), got.FileHash)
+	})
+
+	t.Run("adopts the hash of file_path when the state has no hash", func(t *testing.T) {
+		filePath := filepath.Join(t.TempDir(), "extension.zip")
+		require.NoError(t, os.WriteFile(filePath, contents, 0o644))
+
+		config := baseModel()
+		config.SourceDir = types.StringNull()
+		config.FilePath = types.StringValue(filePath)
+
+		state := config
+		state.Size = types.Int64Value(int64(len(contents)))
+
+		got, resp := run(t, config, state, &state)
+		require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+		expected := state
+		expected.FileHash = types.StringValue(dirHash)
+		assert.Equal(t, expected, got)
+	})
+
+	t.Run("plans a new upload when the state has no hash and the file size differs", func(t *testing.T) {
+		filePath := filepath.Join(t.TempDir(), "extension.zip")
+		require.NoError(t, os.WriteFile(filePath, contents, 0o644))
+
+		config := baseModel()
+		config.SourceDir = types.StringNull()
+		config.FilePath = types.StringValue(filePath)
+
+		state := config
+		state.Size = types.Int64Value(1)
+
+		got, resp := run(t, config, state, &state)
+		require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+		assert.Equal(t, types.StringValue(dirHash), got.FileHash)
+		assert.True(t, got.URL.IsUnknown())
+		assert.True(t, got.LastModified.IsUnknown())
+		assert.True(t, got.Size.IsUnknown())
+	})
+
+	t.Run("leaves the hash unknown when file_path doesn't exist yet", func(t *testing.T) {
+		config := baseModel()
+		config.SourceDir = types.StringNull()
+		config.FilePath = types.StringValue(filepath.Join(t.TempDir(), "missing.zip"))
+
+		got, resp := run(t, config, config, nil)
+		require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+		assert.True(t, got.FileHash.IsUnknown())
+	})
+
+	t.Run("leaves the hash unknown when source_dir is unknown", func(t *testing.T) {
+		config := baseModel()
+		config.SourceDir = types.StringUnknown()
+
+		got, resp := run(t, config, config, nil)
+		require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+		assert.True(t, got.FileHash.IsUnknown())
+	})
+
+	t.Run("reports a missing source_dir", func(t *testing.T) {
+		config := baseModel()
+		config.SourceDir = types.StringValue(dir + "/missing")
+
+		_, resp := run(t, config, config, nil)
+		require.True(t, resp.Diagnostics.HasError())
+		assert.Equal(t, "Failed to archive the extension source directory", resp.Diagnostics.Errors()[0].Summary())
+	})
+}
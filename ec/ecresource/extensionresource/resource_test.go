@@ -272,7 +272,7 @@ resource "ec_deployment_extension" "my_extension" {
   version        = "7.10.1"
   extension_type = "bundle"
   download_url   = "https://example.com"
-  file_path      = "testdata/test_extension_bundle.json"
+  file_path      = "testdata/test_extension_bundle.zip"
   file_hash      = "abcd"
 }
 `
@@ -300,7 +300,7 @@ func checkResource2() r.TestCheckFunc {
 		r.TestCheckResourceAttr(resource, "url", "repo://1234"),
 		r.TestCheckResourceAttr(resource, "last_modified", "2021-01-07T22:13:42.999Z"),
 		r.TestCheckResourceAttr(resource, "size", "1000"),
-		r.TestCheckResourceAttr(resource, "file_path", "testdata/test_extension_bundle.json"),
+		r.TestCheckResourceAttr(resource, "file_path", "testdata/test_extension_bundle.zip"),
 		r.TestCheckResourceAttr(resource, "file_hash", "abcd"),
 	)
 }
@@ -21,6 +21,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -28,6 +29,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
@@ -35,6 +37,7 @@ import (
 
 	"github.com/elastic/terraform-provider-ec/ec/internal"
 	"github.com/elastic/terraform-provider-ec/ec/internal/planmodifiers"
+	"github.com/elastic/terraform-provider-ec/ec/internal/validators"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces
@@ -50,7 +53,7 @@ func (r *Resource) Schema(_ context.Context, _ resource.SchemaRequest, resp *res
 
   Extensions allow users of Elastic Cloud to use custom plugins, scripts, or dictionaries to enhance the core functionality of Elasticsearch. Before you install an extension, be sure to check out the supported and official [Elasticsearch plugins](https://www.elastic.co/guide/en/elasticsearch/plugins/current/index.html) already available.
 
-  **Tip :** If you experience timeouts when uploading an extension through a slow network, you might need to increase the [timeout setting](https://registry.terraform.io/providers/elastic/ec/latest/docs#timeout).
+  **Tip :** If you experience timeouts when uploading a large extension through a slow network, you might need to increase ` + "`upload_timeout`" + `. The upload isn't bound by the provider [timeout setting](https://registry.terraform.io/providers/elastic/ec/latest/docs#timeout).
 `,
 		Attributes: map[string]schema.Attribute{
 			"name": schema.StringAttribute{
@@ -83,13 +86,28 @@ func (r *Resource) Schema(_ context.Context, _ resource.SchemaRequest, resp *res
 			},
 			// Uploading file via API
 			"file_path": schema.StringAttribute{
-				Description: "Local file path to upload as the extension.",
+				Description: "Local file path to upload as the extension. The zip archive is validated before it's uploaded: a plugin must contain a `plugin-descriptor.properties` file whose `elasticsearch.version` matches `version`, and a bundle must contain files, usually in the `dictionaries` and `scripts` directories.",
 				Optional:    true,
 			},
 			"file_hash": schema.StringAttribute{
-				Description: "Hash value of the file. Triggers re-uploading the file on change.",
+				Description: "Hash value of the file. Triggers re-uploading the file on change. When unset, this is the SHA-256 of the file at `file_path`, or of the archive built from `source_dir`. An extension uploaded before the hash was computed adopts it without being uploaded again, unless the size of the file differs from the uploaded one.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("file_path")),
+				},
+			},
+			"source_dir": schema.StringAttribute{
+				Description: "Local directory to archive and upload as the extension, as an alternative to `file_path`. For bundles the directory should contain a `dictionaries` and/or a `scripts` directory. The archive is built deterministically, hidden files are skipped, and it's only uploaded again when the contents of the directory change.",
 				Optional:    true,
 			},
+			"upload_timeout": schema.StringAttribute{
+				Description: "Maximum duration of the extension file upload, as a duration string (e.g `30m`). Defaults to `10m`.",
+				Optional:    true,
+				Validators: []validator.String{
+					validators.PositiveDuration(),
+				},
+			},
 			"url": schema.StringAttribute{
 				Description: "The extension URL which will be used in the Elastic Cloud deployment plan.",
 				Computed:    true,
@@ -192,8 +210,12 @@ func (m useUnknownIfOtherChanges) hasAttributesChanged(ctx context.Context, conf
 
 func (r *Resource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
 	return []resource.ConfigValidator{
-		resourcevalidator.RequiredTogether(
+		resourcevalidator.Conflicting(
+			path.MatchRoot("source_dir"),
 			path.MatchRoot("file_path"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("source_dir"),
 			path.MatchRoot("file_hash"),
 		),
 	}
@@ -238,6 +260,8 @@ type modelV0 struct {
 	DownloadURL   types.String `tfsdk:"download_url"`
 	FilePath      types.String `tfsdk:"file_path"`
 	FileHash      types.String `tfsdk:"file_hash"`
+	SourceDir     types.String `tfsdk:"source_dir"`
+	UploadTimeout types.String `tfsdk:"upload_timeout"`
 	URL           types.String `tfsdk:"url"`
 	LastModified  types.String `tfsdk:"last_modified"`
 	Size          types.Int64  `tfsdk:"size"`
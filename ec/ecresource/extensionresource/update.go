@@ -21,6 +21,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/elastic/cloud-sdk-go/pkg/api/deploymentapi/extensionapi"
 )
@@ -61,15 +62,24 @@ func (r *Resource) Update(ctx context.Context, request resource.UpdateRequest, r
 		return
 	}
 
-	hasChanges := !oldState.FileHash.Equal(newState.FileHash) ||
+	// An extension uploaded before its hash was tracked adopts the planned hash,
+	// it's only uploaded again when the plan expects new file metadata.
+	hashChanged := !oldState.FileHash.IsNull() && !oldState.FileHash.Equal(newState.FileHash)
+	hasChanges := hashChanged ||
 		!oldState.LastModified.Equal(newState.LastModified) ||
 		!oldState.Size.Equal(newState.Size)
 
-	if !newState.FilePath.IsNull() && newState.FilePath.ValueString() != "" && hasChanges {
-		response.Diagnostics.Append(r.uploadExtension(newState)...)
+	if hasExtensionFile(newState) && hasChanges {
+		fileHash, diags := r.uploadExtension(ctx, newState)
+		response.Diagnostics.Append(diags...)
 		if response.Diagnostics.HasError() {
 			return
 		}
+
+		// The hash couldn't be computed at plan time, keep the one of the uploaded file.
+		if newState.FileHash.IsUnknown() {
+			newState.FileHash = types.StringValue(fileHash)
+		}
 	}
 
 	found, diags := r.read(newState.ID.ValueString(), &newState)
@@ -0,0 +1,145 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package extensionresource
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/cloud-sdk-go/pkg/api"
+	"github.com/elastic/cloud-sdk-go/pkg/api/mock"
+)
+
+func TestResource_Update(t *testing.T) {
+	ctx := context.Background()
+
+	var schemaResp resource.SchemaResponse
+	(&Resource{}).Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	sch := schemaResp.Schema
+
+	contents := []byte("extension contents")
+	filePath := filepath.Join(t.TempDir(), "extension.zip")
+	require.NoError(t, os.WriteFile(filePath, contents, 0o644))
+	fileHash := hashContents(contents)
+
+	extensionBody := fmt.Sprintf(`{
+		"id": "extension-id",
+		"name": "my_extension",
+		"extension_type": "bundle",
+		"version": "*",
+		"url": "repo://1234",
+		"file_metadata": {"last_modified_date": "2024-01-01T00:00:00.000Z", "size": %d}
+	}`, len(contents))
+
+	response := func(body string) mock.Response {
+		return mock.New200Response(mock.NewStringBody(body))
+	}
+
+	baseModel := func() modelV0 {
+		return modelV0{
+			ID:            types.StringValue("extension-id"),
+			Name:          types.StringValue("my_extension"),
+			Description:   types.StringValue(""),
+			ExtensionType: types.StringValue("bundle"),
+			Version:       types.StringValue("*"),
+			DownloadURL:   types.StringValue(""),
+			FilePath:      types.StringValue(filePath),
+			FileHash:      types.StringNull(),
+			SourceDir:     types.StringNull(),
+			UploadTimeout: types.StringNull(),
+			URL:           types.StringValue("repo://1234"),
+			LastModified:  types.StringValue("2024-01-01T00:00:00.000Z"),
+			Size:          types.Int64Value(int64(len(contents))),
+		}
+	}
+
+	run := func(t *testing.T, client *api.API, plan, state modelV0) (modelV0, *resource.UpdateResponse) {
+		req := resource.UpdateRequest{
+			Plan:  tfsdk.Plan{Schema: sch},
+			State: tfsdk.State{Schema: sch},
+		}
+		require.False(t, req.Plan.Set(ctx, plan).HasError())
+		require.False(t, req.State.Set(ctx, state).HasError())
+
+		resp := &resource.UpdateResponse{
+			State: tfsdk.State{Schema: sch, Raw: tftypes.NewValue(sch.Type().TerraformType(ctx), nil)},
+		}
+		(&Resource{client: client}).Update(ctx, req, resp)
+
+		var got modelV0
+		if !resp.Diagnostics.HasError() {
+			require.False(t, resp.State.Get(ctx, &got).HasError())
+		}
+		return got, resp
+	}
+
+	t.Run("adopts the hash without uploading the file again", func(t *testing.T) {
+		plan := baseModel()
+		plan.FileHash = types.StringValue(fileHash)
+
+		// No upload response is mocked, an upload fails the update.
+		client := api.NewMock(response(extensionBody), response(extensionBody))
+
+		got, resp := run(t, client, plan, baseModel())
+		require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+		assert.Equal(t, types.StringValue(fileHash), got.FileHash)
+	})
+
+	t.Run("uploads the file when the file metadata is expected to change", func(t *testing.T) {
+		plan := baseModel()
+		plan.FileHash = types.StringValue(fileHash)
+		plan.LastModified = types.StringUnknown()
+		plan.Size = types.Int64Unknown()
+
+		client := api.NewMock(
+			response(extensionBody),
+			response(fmt.Sprintf(`{"file_metadata": {"size": %d}}`, len(contents))),
+			response(extensionBody),
+		)
+
+		got, resp := run(t, client, plan, baseModel())
+		require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+		assert.Equal(t, types.Int64Value(int64(len(contents))), got.Size)
+	})
+
+	t.Run("uploads the file when its hash changes", func(t *testing.T) {
+		state := baseModel()
+		state.FileHash = types.StringValue("previous-hash")
+		plan := baseModel()
+		plan.FileHash = types.StringValue(fileHash)
+
+		client := api.NewMock(
+			response(extensionBody),
+			response(fmt.Sprintf(`{"file_metadata": {"size": %d}}`, len(contents))),
+			response(extensionBody),
+		)
+
+		_, resp := run(t, client, plan, state)
+		require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+	})
+}
@@ -18,31 +18,159 @@
 package extensionresource
 
 import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
 	"os"
+	"time"
 
+	"github.com/go-openapi/runtime"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 
-	"github.com/elastic/cloud-sdk-go/pkg/api/deploymentapi/extensionapi"
+	"github.com/elastic/cloud-sdk-go/pkg/api/apierror"
+	"github.com/elastic/cloud-sdk-go/pkg/client/extensions"
 )
 
-func (r *Resource) uploadExtension(state modelV0) diag.Diagnostics {
+const (
+	defaultUploadTimeout = 10 * time.Minute
+
+	// uploadProgressStep is the upload progress, in percent, between two
+	// progress log messages.
+	uploadProgressStep = 10
+)
+
+// hasExtensionFile returns true when the extension is uploaded from a local
+// file or directory rather than downloaded from `download_url`.
+func hasExtensionFile(state modelV0) bool {
+	return (!state.FilePath.IsNull() && state.FilePath.ValueString() != "") ||
+		(!state.SourceDir.IsNull() && state.SourceDir.ValueString() != "")
+}
+
+// uploadExtension streams the extension file to the API, logging the upload
+// progress, and checks the size of the uploaded file. It returns the hash of
+// the uploaded file.
+func (r *Resource) uploadExtension(ctx context.Context, state modelV0) (string, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
-	reader, err := os.Open(state.FilePath.ValueString())
+	file, size, hash, err := openExtensionFile(state)
 	if err != nil {
 		diags.AddError("failed to open file", err.Error())
-		return diags
+		return "", diags
+	}
+	defer file.Close()
+
+	timeout, err := uploadTimeout(state)
+	if err != nil {
+		diags.AddError("invalid upload timeout", err.Error())
+		return "", diags
+	}
+
+	reader := &progressReader{
+		ctx:    ctx,
+		reader: file,
+		size:   size,
 	}
 
-	_, err = extensionapi.Upload(extensionapi.UploadParams{
-		API:         r.client,
-		ExtensionID: state.ID.ValueString(),
-		File:        reader,
+	tflog.Info(ctx, "uploading extension file", map[string]any{
+		"extension_id": state.ID.ValueString(),
+		"size":         size,
+		"timeout":      timeout.String(),
 	})
+
+	res, err := r.client.V1API.Extensions.UploadExtension(
+		extensions.NewUploadExtensionParams().
+			WithContext(ctx).
+			WithTimeout(timeout).
+			WithExtensionID(state.ID.ValueString()).
+			WithFile(runtime.NamedReader("Extension", reader)),
+		r.client.AuthWriter,
+	)
+	if err != nil {
+		diags.AddError("failed to upload file", apierror.Wrap(err).Error())
+		return "", diags
+	}
+
+	if res.Payload != nil && res.Payload.FileMetadata != nil && res.Payload.FileMetadata.Size != size {
+		diags.AddError(
+			"extension upload size mismatch",
+			fmt.Sprintf("The uploaded extension file is %d bytes, but the local file is %d bytes. Try uploading the extension again.", res.Payload.FileMetadata.Size, size),
+		)
+		return "", diags
+	}
+
+	return hash, diags
+}
+
+// openExtensionFile opens the file to upload, archiving `source_dir` when
+// set, and returns its size and hash. The archive must match the hash planned
+// for `source_dir`, unless it couldn't be computed at plan time.
+func openExtensionFile(state modelV0) (io.ReadCloser, int64, string, error) {
+	if state.SourceDir.IsNull() || state.SourceDir.ValueString() == "" {
+		hash, err := hashFile(state.FilePath.ValueString())
+		if err != nil {
+			return nil, 0, "", err
+		}
+
+		file, err := os.Open(state.FilePath.ValueString())
+		if err != nil {
+			return nil, 0, "", err
+		}
+
+		info, err := file.Stat()
+		if err != nil {
+			file.Close()
+			return nil, 0, "", err
+		}
+
+		return file, info.Size(), hash, nil
+	}
+
+	contents, err := archiveDirectory(state.SourceDir.ValueString())
 	if err != nil {
-		diags.AddError("failed to upload file", err.Error())
-		return diags
+		return nil, 0, "", err
+	}
+
+	hash := hashContents(contents)
+	if !state.FileHash.IsUnknown() && hash != state.FileHash.ValueString() {
+		return nil, 0, "", fmt.Errorf("the contents of %s changed after the plan was created (expected hash %s, got %s), run terraform apply again", state.SourceDir.ValueString(), state.FileHash.ValueString(), hash)
+	}
+
+	return io.NopCloser(bytes.NewReader(contents)), int64(len(contents)), hash, nil
+}
+
+func uploadTimeout(state modelV0) (time.Duration, error) {
+	if state.UploadTimeout.IsNull() || state.UploadTimeout.IsUnknown() {
+		return defaultUploadTimeout, nil
+	}
+	return time.ParseDuration(state.UploadTimeout.ValueString())
+}
+
+// progressReader logs the progress of the upload as the file is read.
+type progressReader struct {
+	ctx    context.Context
+	reader io.Reader
+	size   int64
+
+	read       int64
+	nextReport int64
+}
+
+func (p *progressReader) Read(b []byte) (int, error) {
+	n, err := p.reader.Read(b)
+	p.read += int64(n)
+
+	if p.size > 0 {
+		if progress := p.read * 100 / p.size; progress >= p.nextReport {
+			tflog.Debug(p.ctx, "extension upload progress", map[string]any{
+				"uploaded_bytes": p.read,
+				"total_bytes":    p.size,
+				"progress":       fmt.Sprintf("%d%%", progress),
+			})
+			p.nextReport = progress - progress%uploadProgressStep + uploadProgressStep
+		}
 	}
 
-	return diags
+	return n, err
 }
@@ -0,0 +1,134 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package extensionresource
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/cloud-sdk-go/pkg/api"
+	"github.com/elastic/cloud-sdk-go/pkg/api/mock"
+)
+
+func TestResource_uploadExtension(t *testing.T) {
+	ctx := context.Background()
+	contents := []byte("extension contents")
+	filePath := filepath.Join(t.TempDir(), "extension.zip")
+	require.NoError(t, os.WriteFile(filePath, contents, 0o644))
+
+	state := modelV0{
+		ID:            types.StringValue("extension-id"),
+		FilePath:      types.StringValue(filePath),
+		UploadTimeout: types.StringValue("1m"),
+	}
+
+	uploadResponse := func(body string) mock.Response {
+		return mock.Response{
+			Response: http.Response{
+				StatusCode: http.StatusOK,
+				Status:     http.StatusText(http.StatusOK),
+				Body:       mock.NewStringBody(body),
+			},
+		}
+	}
+
+	t.Run("uploads the extension file", func(t *testing.T) {
+		r := &Resource{client: api.NewMock(uploadResponse(`{"file_metadata": {"size": 18}}`))}
+
+		hash, diags := r.uploadExtension(ctx, state)
+		require.False(t, diags.HasError(), diags)
+		assert.Equal(t, hashContents(contents), hash)
+	})
+
+	t.Run("returns the hash of the archive when source_dir was unknown at plan time", func(t *testing.T) {
+		dir := t.TempDir()
+		writeFiles(t, dir, map[string]string{"dictionaries/synonyms.txt": "tv, television"})
+		archive, err := archiveDirectory(dir)
+		require.NoError(t, err)
+
+		sourceDirState := state
+		sourceDirState.FilePath = types.StringNull()
+		sourceDirState.SourceDir = types.StringValue(dir)
+		sourceDirState.FileHash = types.StringUnknown()
+
+		r := &Resource{client: api.NewMock(uploadResponse(fmt.Sprintf(`{"file_metadata": {"size": %d}}`, len(archive))))}
+
+		hash, diags := r.uploadExtension(ctx, sourceDirState)
+		require.False(t, diags.HasError(), diags)
+		assert.Equal(t, hashContents(archive), hash)
+	})
+
+	t.Run("fails when source_dir changed after the plan", func(t *testing.T) {
+		dir := t.TempDir()
+		writeFiles(t, dir, map[string]string{"dictionaries/synonyms.txt": "tv, television"})
+
+		sourceDirState := state
+		sourceDirState.FilePath = types.StringNull()
+		sourceDirState.SourceDir = types.StringValue(dir)
+		sourceDirState.FileHash = types.StringValue("planned-hash")
+
+		r := &Resource{client: api.NewMock()}
+
+		_, diags := r.uploadExtension(ctx, sourceDirState)
+		require.True(t, diags.HasError())
+		assert.Contains(t, diags.Errors()[0].Detail(), "changed after the plan was created")
+	})
+
+	t.Run("fails when the uploaded size doesn't match the local file", func(t *testing.T) {
+		r := &Resource{client: api.NewMock(uploadResponse(`{"file_metadata": {"size": 10}}`))}
+
+		_, diags := r.uploadExtension(ctx, state)
+		require.True(t, diags.HasError())
+		assert.Equal(t, "extension upload size mismatch", diags.Errors()[0].Summary())
+	})
+
+	t.Run("fails on an invalid upload timeout", func(t *testing.T) {
+		invalid := state
+		invalid.UploadTimeout = types.StringValue("soon")
+		r := &Resource{client: api.NewMock()}
+
+		_, diags := r.uploadExtension(ctx, invalid)
+		require.True(t, diags.HasError())
+		assert.Equal(t, "invalid upload timeout", diags.Errors()[0].Summary())
+	})
+}
+
+func TestProgressReader(t *testing.T) {
+	contents := bytes.Repeat([]byte("a"), 1000)
+	reader := &progressReader{
+		ctx:    context.Background(),
+		reader: bytes.NewReader(contents),
+		size:   int64(len(contents)),
+	}
+
+	got, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	assert.Equal(t, contents, got)
+	assert.Equal(t, int64(len(contents)), reader.read)
+	assert.Equal(t, int64(110), reader.nextReport)
+}
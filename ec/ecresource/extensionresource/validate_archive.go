@@ -0,0 +1,242 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package extensionresource
+
+import (
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	tfpath "github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+const (
+	extensionTypePlugin = "plugin"
+	extensionTypeBundle = "bundle"
+
+	pluginDescriptorFile       = "plugin-descriptor.properties"
+	pluginDescriptorESVersion  = "elasticsearch.version"
+	legacyPluginDescriptorFile = "elasticsearch/" + pluginDescriptorFile
+)
+
+// bundleDirectories are the top level directories Elasticsearch expects in a
+// bundle archive.
+var bundleDirectories = []string{"dictionaries/", "scripts/"}
+
+var _ resource.ResourceWithValidateConfig = &Resource{}
+
+// ValidateConfig opens the extension archive, or the archive built from
+// `source_dir`, to report broken archives before they are uploaded.
+func (r *Resource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var config modelV0
+	response.Diagnostics.Append(request.Config.Get(ctx, &config)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if config.ExtensionType.IsUnknown() || config.Version.IsUnknown() ||
+		config.FilePath.IsUnknown() || config.SourceDir.IsUnknown() {
+		return
+	}
+
+	var (
+		archive     *zip.Reader
+		archivePath tfpath.Path
+		err         error
+	)
+	switch {
+	case !config.SourceDir.IsNull():
+		archivePath = tfpath.Root("source_dir")
+		var contents []byte
+		// Errors are reported when the plan is computed.
+		if contents, err = archiveDirectory(config.SourceDir.ValueString()); err != nil {
+			return
+		}
+		archive, err = zip.NewReader(bytes.NewReader(contents), int64(len(contents)))
+	case !config.FilePath.IsNull():
+		archivePath = tfpath.Root("file_path")
+		var reader *zip.ReadCloser
+		reader, err = zip.OpenReader(config.FilePath.ValueString())
+		// The file may be created by another resource during the apply.
+		if errors.Is(err, fs.ErrNotExist) {
+			return
+		}
+		if err == nil {
+			defer reader.Close()
+			archive = &reader.Reader
+		}
+	default:
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddAttributeError(
+			archivePath,
+			"Invalid extension archive",
+			fmt.Sprintf("The extension must be a zip archive: %s", err),
+		)
+		return
+	}
+
+	response.Diagnostics.Append(validateArchive(archive, archivePath, config.ExtensionType.ValueString(), config.Version.ValueString())...)
+}
+
+func validateArchive(archive *zip.Reader, archivePath tfpath.Path, extensionType, version string) diag.Diagnostics {
+	switch extensionType {
+	case extensionTypePlugin:
+		return validatePluginArchive(archive, archivePath, version)
+	case extensionTypeBundle:
+		return validateBundleArchive(archive, archivePath)
+	default:
+		return nil
+	}
+}
+
+// validatePluginArchive checks that the archive holds a plugin descriptor
+// built for the configured Elasticsearch version.
+func validatePluginArchive(archive *zip.Reader, archivePath tfpath.Path, version string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var descriptor *zip.File
+	for _, f := range archive.File {
+		if f.Name == pluginDescriptorFile || f.Name == legacyPluginDescriptorFile {
+			descriptor = f
+			break
+		}
+	}
+
+	if descriptor == nil {
+		diags.AddAttributeError(
+			archivePath,
+			"Invalid plugin archive",
+			fmt.Sprintf("The plugin archive must contain a %s file at its root.", pluginDescriptorFile),
+		)
+		return diags
+	}
+
+	properties, err := readProperties(descriptor)
+	if err != nil {
+		diags.AddAttributeError(
+			archivePath,
+			"Invalid plugin archive",
+			fmt.Sprintf("Failed to read %s: %s", descriptor.Name, err),
+		)
+		return diags
+	}
+
+	esVersion, ok := properties[pluginDescriptorESVersion]
+	if !ok {
+		diags.AddAttributeError(
+			archivePath,
+			"Invalid plugin archive",
+			fmt.Sprintf("The %s file of the plugin doesn't define %s.", descriptor.Name, pluginDescriptorESVersion),
+		)
+		return diags
+	}
+
+	if matched, _ := path.Match(version, esVersion); !matched {
+		diags.AddAttributeError(
+			tfpath.Root("version"),
+			"Plugin version mismatch",
+			fmt.Sprintf("The plugin is built for Elasticsearch %s, which doesn't match the extension version %s. Plugins must be built for the exact Elasticsearch version they're installed on.", esVersion, version),
+		)
+	}
+
+	return diags
+}
+
+// validateBundleArchive checks that the archive holds files, and warns about
+// the ones which are outside of the directories Elasticsearch expects.
+func validateBundleArchive(archive *zip.Reader, archivePath tfpath.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var files, unexpected []string
+	for _, f := range archive.File {
+		if f.FileInfo().IsDir() {
+			continue
+		}
+		files = append(files, f.Name)
+
+		if !hasAnyPrefix(f.Name, bundleDirectories) {
+			unexpected = append(unexpected, f.Name)
+		}
+	}
+
+	if len(files) == 0 {
+		diags.AddAttributeError(
+			archivePath,
+			"Invalid bundle archive",
+			"The bundle archive doesn't contain any file.",
+		)
+		return diags
+	}
+
+	if len(unexpected) > 0 {
+		diags.AddAttributeWarning(
+			archivePath,
+			"Unexpected bundle layout",
+			fmt.Sprintf("Bundles usually hold their files in the %s directories, the following files are outside of them: %s",
+				strings.Join(bundleDirectories, " or "), strings.Join(unexpected, ", ")),
+		)
+	}
+
+	return diags
+}
+
+// readProperties parses a Java properties file, as used by the plugin descriptor.
+func readProperties(f *zip.File) (map[string]string, error) {
+	reader, err := f.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	properties := make(map[string]string)
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, "!") {
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			key, value, _ = strings.Cut(line, ":")
+		}
+		properties[strings.TrimSpace(key)] = strings.TrimSpace(value)
+	}
+
+	return properties, scanner.Err()
+}
+
+func hasAnyPrefix(s string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(s, prefix) {
+			return true
+		}
+	}
+	return false
+}
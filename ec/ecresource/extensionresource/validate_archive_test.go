@@ -0,0 +1,198 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package extensionresource
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	tfpath "github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func zipFiles(t *testing.T, files map[string]string) *zip.Reader {
+	t.Helper()
+
+	dir := t.TempDir()
+	writeFiles(t, dir, files)
+	contents, err := archiveDirectory(dir)
+	require.NoError(t, err)
+
+	reader, err := zip.NewReader(bytes.NewReader(contents), int64(len(contents)))
+	require.NoError(t, err)
+	return reader
+}
+
+func Test_validateArchive(t *testing.T) {
+	archivePath := tfpath.Root("file_path")
+
+	tests := []struct {
+		name          string
+		extensionType string
+		version       string
+		files         map[string]string
+		wantError     string
+		wantWarning   string
+	}{
+		{
+			name:          "valid plugin",
+			extensionType: "plugin",
+			version:       "8.7.0",
+			files: map[string]string{
+				"plugin-descriptor.properties": "# comment\nname=my-plugin\nelasticsearch.version = 8.7.0\n",
+				"my-plugin.jar":                "jar",
+			},
+		},
+		{
+			name:          "valid legacy plugin",
+			extensionType: "plugin",
+			version:       "8.*",
+			files: map[string]string{
+				"elasticsearch/plugin-descriptor.properties": "elasticsearch.version: 8.7.0",
+			},
+		},
+		{
+			name:          "plugin without descriptor",
+			extensionType: "plugin",
+			version:       "8.7.0",
+			files:         map[string]string{"my-plugin.jar": "jar"},
+			wantError:     "must contain a plugin-descriptor.properties file",
+		},
+		{
+			name:          "plugin descriptor without version",
+			extensionType: "plugin",
+			version:       "8.7.0",
+			files:         map[string]string{"plugin-descriptor.properties": "name=my-plugin"},
+			wantError:     "doesn't define elasticsearch.version",
+		},
+		{
+			name:          "plugin built for another version",
+			extensionType: "plugin",
+			version:       "8.8.0",
+			files:         map[string]string{"plugin-descriptor.properties": "elasticsearch.version=8.7.0"},
+			wantError:     "built for Elasticsearch 8.7.0, which doesn't match the extension version 8.8.0",
+		},
+		{
+			name:          "valid bundle",
+			extensionType: "bundle",
+			version:       "*",
+			files: map[string]string{
+				"dictionaries/synonyms.txt":  "tv, television",
+				"scripts/my-script.painless": "return 1;",
+			},
+		},
+		{
+			name:          "bundle with files outside of the expected directories",
+			extensionType: "bundle",
+			version:       "*",
+			files: map[string]string{
+				"dictionaries/synonyms.txt": "tv, television",
+				"synonyms.txt":              "tv, television",
+			},
+			wantWarning: "the following files are outside of them: synonyms.txt",
+		},
+		{
+			name:          "empty bundle",
+			extensionType: "bundle",
+			version:       "*",
+			files:         map[string]string{},
+			wantError:     "doesn't contain any file",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := validateArchive(zipFiles(t, tt.files), archivePath, tt.extensionType, tt.version)
+
+			assertDiagnostic(t, diags.Errors(), tt.wantError)
+			assertDiagnostic(t, diags.Warnings(), tt.wantWarning)
+		})
+	}
+}
+
+func assertDiagnostic(t *testing.T, diags diag.Diagnostics, want string) {
+	t.Helper()
+
+	if want == "" {
+		assert.Empty(t, diags)
+		return
+	}
+
+	require.Len(t, diags, 1)
+	assert.Contains(t, diags[0].Detail(), want)
+}
+
+func TestResource_ValidateConfig(t *testing.T) {
+	ctx := context.Background()
+
+	var schemaResp resource.SchemaResponse
+	(&Resource{}).Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	validate := func(t *testing.T, filePath string) diag.Diagnostics {
+		state := tfsdk.State{Schema: schemaResp.Schema}
+		require.False(t, state.Set(ctx, modelV0{
+			ID:            types.StringNull(),
+			Name:          types.StringValue("my_extension"),
+			Description:   types.StringNull(),
+			ExtensionType: types.StringValue("plugin"),
+			Version:       types.StringValue("8.7.0"),
+			DownloadURL:   types.StringNull(),
+			FilePath:      types.StringValue(filePath),
+			FileHash:      types.StringValue("hash"),
+			SourceDir:     types.StringNull(),
+			URL:           types.StringNull(),
+			LastModified:  types.StringNull(),
+			Size:          types.Int64Null(),
+		}).HasError())
+
+		response := &resource.ValidateConfigResponse{}
+		(&Resource{}).ValidateConfig(ctx, resource.ValidateConfigRequest{
+			Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: state.Raw},
+		}, response)
+		return response.Diagnostics
+	}
+
+	t.Run("reports a file which isn't a zip archive", func(t *testing.T) {
+		filePath := filepath.Join(t.TempDir(), "plugin.zip")
+		require.NoError(t, os.WriteFile(filePath, []byte(`{"foo": "bar"}`), 0o600))
+
+		diags := validate(t, filePath)
+		require.True(t, diags.HasError())
+		assert.Equal(t, "Invalid extension archive", diags.Errors()[0].Summary())
+	})
+
+	t.Run("skips a file which doesn't exist yet", func(t *testing.T) {
+		diags := validate(t, filepath.Join(t.TempDir(), "plugin.zip"))
+		assert.Empty(t, diags)
+	})
+
+	t.Run("validates the plugin archive", func(t *testing.T) {
+		diags := validate(t, "testdata/test_extension_bundle.zip")
+		require.True(t, diags.HasError())
+		assert.Equal(t, "Invalid plugin archive", diags.Errors()[0].Summary())
+	})
+}
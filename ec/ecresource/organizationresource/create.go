@@ -19,8 +19,6 @@ package organizationresource
 
 import (
 	"context"
-	"github.com/elastic/cloud-sdk-go/pkg/api/organizationapi"
-	"github.com/elastic/cloud-sdk-go/pkg/models"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 )
@@ -31,30 +29,16 @@ func (r *Resource) Create(ctx context.Context, request resource.CreateRequest, r
 	response.Diagnostics.AddError("organization already exists", "please import the organization using terraform import")
 }
 
-func (r *Resource) createInvitation(ctx context.Context, email string, plan OrganizationMember, organizationID string, diagnostics *diag.Diagnostics) *OrganizationMember {
+func (r *Resource) createInvitation(ctx context.Context, email string, plan OrganizationMember, organizationID string, expiresIn string, diagnostics *diag.Diagnostics) *OrganizationMember {
 	apiModel := modelToApi(ctx, plan, organizationID, diagnostics)
 	if diagnostics.HasError() {
 		return nil
 	}
 
-	invitations, err := organizationapi.CreateInvitation(organizationapi.CreateInvitationParams{
-		API:             r.client,
-		OrganizationID:  organizationID,
-		Emails:          []string{email},
-		ExpiresIn:       "7d",
-		RoleAssignments: apiModel.RoleAssignments,
-	})
-	if err != nil {
-		diagnostics.Append(diag.NewErrorDiagnostic("Failed to create invitation", err.Error()))
+	invitation := r.invite(organizationID, email, apiModel.RoleAssignments, expiresIn, diagnostics)
+	if diagnostics.HasError() {
 		return nil
 	}
 
-	invitation := invitations.Invitations[0]
-	organizationMember := apiToModel(ctx, models.OrganizationMembership{
-		Email:           *invitation.Email,
-		OrganizationID:  invitation.Organization.ID,
-		RoleAssignments: invitation.RoleAssignments,
-	}, true, diagnostics)
-
-	return organizationMember
+	return invitationToModel(ctx, invitation, &plan, diagnostics)
 }
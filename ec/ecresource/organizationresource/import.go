@@ -25,7 +25,7 @@ import (
 func (r *Resource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
 	organizationID := request.ID
 
-	result := r.readFromApi(ctx, organizationID, &response.Diagnostics)
+	result := r.readFromApi(ctx, organizationID, InvitationSettings{}.withDefaults(), nil, &response.Diagnostics)
 	if response.Diagnostics.HasError() {
 		return
 	}
@@ -0,0 +1,258 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package organizationresource
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"regexp"
+	"time"
+
+	"github.com/elastic/cloud-sdk-go/pkg/api/organizationapi"
+	"github.com/elastic/cloud-sdk-go/pkg/models"
+	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/elastic/terraform-provider-ec/ec/internal/planmodifiers"
+)
+
+const (
+	defaultInvitationExpiresIn = "7d"
+
+	PendingInvitationActionWarn = "warn"
+	PendingInvitationActionFail = "fail"
+)
+
+// now is replaced in tests to check the age of pending invitations.
+var now = time.Now
+
+// InvitationSettings controls the lifecycle of the invitations sent to new
+// members of an organization.
+type InvitationSettings struct {
+	InvitationExpiresIn      types.String `tfsdk:"invitation_expires_in"`
+	PendingInvitationMaxDays types.Int64  `tfsdk:"pending_invitation_max_days"`
+	PendingInvitationAction  types.String `tfsdk:"pending_invitation_action"`
+}
+
+func invitationSettingsAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"invitation_expires_in": schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("How long invitations sent to new members stay valid, as a number of hours or days, e.g. `72h` or `14d`. Expired invitations are sent again the next time the members are read, until they are accepted. Defaults to `%s`.", defaultInvitationExpiresIn),
+			Optional:            true,
+			Computed:            true,
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(regexp.MustCompile(`^[1-9][0-9]*[hd]$`), "must be a number of hours or days, e.g. 72h or 14d"),
+			},
+			PlanModifiers: []planmodifier.String{
+				planmodifiers.StringDefaultValue(defaultInvitationExpiresIn),
+			},
+		},
+		"pending_invitation_max_days": schema.Int64Attribute{
+			MarkdownDescription: "Report members who haven't accepted their invitation this many days after they were first invited. The report is made when planning, according to `pending_invitation_action`.",
+			Optional:            true,
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+		},
+		"pending_invitation_action": schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("How members who are pending for longer than `pending_invitation_max_days` are reported. Can be one of `%s` (a warning) or `%s` (an error that stops the plan). Defaults to `%s`.", PendingInvitationActionWarn, PendingInvitationActionFail, PendingInvitationActionWarn),
+			Optional:            true,
+			Computed:            true,
+			Validators: []validator.String{
+				stringvalidator.OneOf(PendingInvitationActionWarn, PendingInvitationActionFail),
+			},
+			PlanModifiers: []planmodifier.String{
+				planmodifiers.StringDefaultValue(PendingInvitationActionWarn),
+			},
+		},
+	}
+}
+
+// withAttributes returns a copy of attributes extended with others.
+func withAttributes(attributes map[string]schema.Attribute, others map[string]schema.Attribute) map[string]schema.Attribute {
+	result := maps.Clone(attributes)
+	maps.Copy(result, others)
+	return result
+}
+
+// withDefaults fills the settings missing from the state, e.g. after an
+// import or an upgrade of the provider.
+func (s InvitationSettings) withDefaults() InvitationSettings {
+	if s.InvitationExpiresIn.IsNull() || s.InvitationExpiresIn.IsUnknown() {
+		s.InvitationExpiresIn = types.StringValue(defaultInvitationExpiresIn)
+	}
+	if s.PendingInvitationAction.IsNull() || s.PendingInvitationAction.IsUnknown() {
+		s.PendingInvitationAction = types.StringValue(PendingInvitationActionWarn)
+	}
+	return s
+}
+
+func (s InvitationSettings) expiresIn() string {
+	return s.withDefaults().InvitationExpiresIn.ValueString()
+}
+
+// invite sends an invitation to join the organization to email.
+func (r *Resource) invite(organizationID, email string, roleAssignments *models.RoleAssignments, expiresIn string, diagnostics *diag.Diagnostics) *models.OrganizationInvitation {
+	invitations, err := organizationapi.CreateInvitation(organizationapi.CreateInvitationParams{
+		API:             r.client,
+		OrganizationID:  organizationID,
+		Emails:          []string{email},
+		ExpiresIn:       expiresIn,
+		RoleAssignments: roleAssignments,
+	})
+	if err != nil {
+		diagnostics.Append(diag.NewErrorDiagnostic("Failed to create invitation", err.Error()))
+		return nil
+	}
+	if len(invitations.Invitations) == 0 {
+		diagnostics.Append(diag.NewErrorDiagnostic("Failed to create invitation", fmt.Sprintf("No invitation was returned for %s.", email)))
+		return nil
+	}
+
+	return invitations.Invitations[0]
+}
+
+// readInvitation converts a pending invitation to a member. An expired
+// invitation is replaced by a new one with the same role assignments, so that
+// the member doesn't stay pending forever. previous is the member as it was
+// known before, if any, to keep track of when it was first invited.
+func (r *Resource) readInvitation(
+	ctx context.Context,
+	invitation *models.OrganizationInvitation,
+	expiresIn string,
+	previous *OrganizationMember,
+	diagnostics *diag.Diagnostics,
+) *OrganizationMember {
+	if invitation.Expired != nil && *invitation.Expired {
+		invitation = r.renewInvitation(invitation, expiresIn, diagnostics)
+		if diagnostics.HasError() {
+			return nil
+		}
+	}
+
+	return invitationToModel(ctx, invitation, previous, diagnostics)
+}
+
+func (r *Resource) renewInvitation(invitation *models.OrganizationInvitation, expiresIn string, diagnostics *diag.Diagnostics) *models.OrganizationInvitation {
+	organizationID := *invitation.Organization.ID
+
+	_, err := organizationapi.DeleteInvitation(organizationapi.DeleteInvitationParams{
+		API:              r.client,
+		OrganizationID:   organizationID,
+		InvitationTokens: []string{*invitation.Token},
+	})
+	if err != nil {
+		diagnostics.Append(diag.NewErrorDiagnostic("Removing expired invitation failed", err.Error()))
+		return nil
+	}
+
+	return r.invite(organizationID, *invitation.Email, invitation.RoleAssignments, expiresIn, diagnostics)
+}
+
+func invitationToModel(ctx context.Context, invitation *models.OrganizationInvitation, previous *OrganizationMember, diagnostics *diag.Diagnostics) *OrganizationMember {
+	member := apiToModel(ctx, models.OrganizationMembership{
+		Email:           *invitation.Email,
+		OrganizationID:  invitation.Organization.ID,
+		RoleAssignments: invitation.RoleAssignments,
+	}, true, diagnostics)
+	if member == nil {
+		return nil
+	}
+
+	member.InvitationExpiresAt = dateTimeToModel(invitation.ExpiresAt)
+	member.InvitedAt = dateTimeToModel(invitation.CreatedAt)
+
+	// A member invited again, after a change of roles or because the previous
+	// invitation expired, is still pending since the first invitation.
+	if previous != nil && previous.InvitationPending.ValueBool() && !previous.InvitedAt.IsNull() && !previous.InvitedAt.IsUnknown() {
+		member.InvitedAt = previous.InvitedAt
+	}
+
+	return member
+}
+
+func dateTimeToModel(value *strfmt.DateTime) types.String {
+	if value == nil {
+		return types.StringNull()
+	}
+	return types.StringValue(time.Time(*value).UTC().Format(time.RFC3339))
+}
+
+// checkPendingInvitation reports member when it has not accepted its
+// invitation within the number of days allowed by the settings.
+func checkPendingInvitation(member OrganizationMember, settings InvitationSettings, attribute path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if !member.InvitationPending.ValueBool() || settings.PendingInvitationMaxDays.IsNull() || settings.PendingInvitationMaxDays.IsUnknown() {
+		return diags
+	}
+
+	invitedAt, err := time.Parse(time.RFC3339, member.InvitedAt.ValueString())
+	if err != nil {
+		return diags
+	}
+
+	days := int64(now().Sub(invitedAt) / (24 * time.Hour))
+	if days < settings.PendingInvitationMaxDays.ValueInt64() {
+		return diags
+	}
+
+	summary := "Organization invitation not accepted"
+	detail := fmt.Sprintf(
+		"%s was invited %d days ago and still hasn't accepted the invitation to join the organization (pending_invitation_max_days = %d).",
+		member.Email.ValueString(), days, settings.PendingInvitationMaxDays.ValueInt64(),
+	)
+	if settings.withDefaults().PendingInvitationAction.ValueString() == PendingInvitationActionFail {
+		diags.AddAttributeError(attribute, summary, detail)
+	} else {
+		diags.AddAttributeWarning(attribute, summary, detail)
+	}
+	return diags
+}
+
+// rolesChanged returns whether the role assignments of a member are changed
+// by the plan.
+func rolesChanged(state, plan OrganizationMember) bool {
+	return !state.OrganizationRole.Equal(plan.OrganizationRole) ||
+		!state.DeploymentRoles.Equal(plan.DeploymentRoles) ||
+		!state.ProjectElasticsearchRoles.Equal(plan.ProjectElasticsearchRoles) ||
+		!state.ProjectObservabilityRoles.Equal(plan.ProjectObservabilityRoles) ||
+		!state.ProjectSecurityRoles.Equal(plan.ProjectSecurityRoles)
+}
+
+// planInvitation plans the invitation attributes of a member already in the
+// state. Members who joined the organization have no invitation, while the
+// expiry of a pending invitation changes when the roles of the member change,
+// since the invitation is sent again.
+func planInvitation(state OrganizationMember, plan *OrganizationMember) {
+	if !state.InvitationPending.ValueBool() {
+		plan.InvitationExpiresAt = types.StringNull()
+		plan.InvitedAt = types.StringNull()
+		return
+	}
+	if rolesChanged(state, *plan) {
+		plan.InvitationExpiresAt = types.StringUnknown()
+	}
+}
@@ -0,0 +1,181 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package organizationresource
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/elastic/cloud-sdk-go/pkg/api"
+	"github.com/elastic/cloud-sdk-go/pkg/api/mock"
+	"github.com/elastic/cloud-sdk-go/pkg/models"
+	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadInvitation(t *testing.T) {
+	ctx := context.Background()
+
+	createdAt := strfmt.DateTime(time.Date(2026, 1, 10, 8, 0, 0, 0, time.UTC))
+	expiresAt := strfmt.DateTime(time.Date(2026, 1, 17, 8, 0, 0, 0, time.UTC))
+	renewedAt := strfmt.DateTime(time.Date(2026, 1, 20, 8, 0, 0, 0, time.UTC))
+	renewedExpiresAt := strfmt.DateTime(time.Date(2026, 2, 3, 8, 0, 0, 0, time.UTC))
+
+	roleAssignments := &models.RoleAssignments{
+		Organization: []*models.OrganizationRoleAssignment{
+			{OrganizationID: new("123"), RoleID: new("billing-admin")},
+		},
+		Project: &models.ProjectRoleAssignments{},
+	}
+	invitation := func(token string, expired bool, createdAt, expiresAt strfmt.DateTime) *models.OrganizationInvitation {
+		return &models.OrganizationInvitation{
+			Token:           new(token),
+			CreatedAt:       &createdAt,
+			Email:           new("user@example.com"),
+			Expired:         new(expired),
+			ExpiresAt:       &expiresAt,
+			Organization:    &models.Organization{ID: new("123")},
+			RoleAssignments: roleAssignments,
+		}
+	}
+
+	t.Run("keeps an invitation which hasn't expired", func(t *testing.T) {
+		r := &Resource{client: api.NewMock()}
+
+		var diags diag.Diagnostics
+		member := r.readInvitation(ctx, invitation("token", false, createdAt, expiresAt), "14d", nil, &diags)
+		require.False(t, diags.HasError(), diags)
+
+		assert.Equal(t, types.BoolValue(true), member.InvitationPending)
+		assert.Equal(t, types.StringValue("2026-01-10T08:00:00Z"), member.InvitedAt)
+		assert.Equal(t, types.StringValue("2026-01-17T08:00:00Z"), member.InvitationExpiresAt)
+	})
+
+	t.Run("renews an expired invitation with the same roles", func(t *testing.T) {
+		r := &Resource{client: api.NewMock(
+			mock.New200ResponseAssertion(
+				&mock.RequestAssertion{
+					Host:   api.DefaultMockHost,
+					Header: api.DefaultReadMockHeaders,
+					Method: "DELETE",
+					Path:   "/api/v1/organizations/123/invitations/expired-token",
+				},
+				mock.NewStringBody("{}"),
+			),
+			mock.New201ResponseAssertion(
+				&mock.RequestAssertion{
+					Host:   api.DefaultMockHost,
+					Header: api.DefaultWriteMockHeaders,
+					Method: "POST",
+					Path:   "/api/v1/organizations/123/invitations",
+					Body: mock.NewStructBody(models.OrganizationInvitationRequest{
+						Emails:          []string{"user@example.com"},
+						ExpiresIn:       "14d",
+						RoleAssignments: roleAssignments,
+					}),
+				},
+				mock.NewStructBody(models.OrganizationInvitations{
+					Invitations: []*models.OrganizationInvitation{invitation("renewed-token", false, renewedAt, renewedExpiresAt)},
+				}),
+			),
+		)}
+
+		previous := OrganizationMember{
+			InvitationPending: types.BoolValue(true),
+			InvitedAt:         types.StringValue("2026-01-10T08:00:00Z"),
+		}
+
+		var diags diag.Diagnostics
+		member := r.readInvitation(ctx, invitation("expired-token", true, createdAt, expiresAt), "14d", &previous, &diags)
+		require.False(t, diags.HasError(), diags)
+
+		assert.Equal(t, types.BoolValue(true), member.InvitationPending)
+		assert.Equal(t, types.StringValue("2026-01-10T08:00:00Z"), member.InvitedAt)
+		assert.Equal(t, types.StringValue("2026-02-03T08:00:00Z"), member.InvitationExpiresAt)
+		assert.Equal(t, types.StringValue("billing-admin"), member.OrganizationRole)
+	})
+}
+
+func TestCheckPendingInvitation(t *testing.T) {
+	defer func(previous func() time.Time) { now = previous }(now)
+	now = func() time.Time { return time.Date(2026, 1, 31, 12, 0, 0, 0, time.UTC) }
+
+	pending := OrganizationMember{
+		Email:             types.StringValue("user@example.com"),
+		InvitationPending: types.BoolValue(true),
+		InvitedAt:         types.StringValue("2026-01-10T08:00:00Z"),
+	}
+	attribute := path.Root("email")
+	detail := "user@example.com was invited 21 days ago and still hasn't accepted the invitation to join the organization (pending_invitation_max_days = 14)."
+
+	tests := []struct {
+		name     string
+		member   OrganizationMember
+		settings InvitationSettings
+		expected diag.Diagnostics
+	}{
+		{
+			name:     "does nothing without a maximum",
+			member:   pending,
+			settings: InvitationSettings{},
+		},
+		{
+			name:     "does nothing while the member is pending for less days",
+			member:   pending,
+			settings: InvitationSettings{PendingInvitationMaxDays: types.Int64Value(30)},
+		},
+		{
+			name: "does nothing once the member accepted",
+			member: OrganizationMember{
+				Email:             types.StringValue("user@example.com"),
+				InvitationPending: types.BoolValue(false),
+			},
+			settings: InvitationSettings{PendingInvitationMaxDays: types.Int64Value(14)},
+		},
+		{
+			name:     "warns by default",
+			member:   pending,
+			settings: InvitationSettings{PendingInvitationMaxDays: types.Int64Value(14)},
+			expected: diag.Diagnostics{
+				diag.NewAttributeWarningDiagnostic(attribute, "Organization invitation not accepted", detail),
+			},
+		},
+		{
+			name:   "fails when configured to",
+			member: pending,
+			settings: InvitationSettings{
+				PendingInvitationMaxDays: types.Int64Value(14),
+				PendingInvitationAction:  types.StringValue(PendingInvitationActionFail),
+			},
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(attribute, "Organization invitation not accepted", detail),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, checkPendingInvitation(tt.member, tt.settings, attribute))
+		})
+	}
+}
@@ -25,6 +25,16 @@ import (
 	"sort"
 )
 
+// RoleAssignmentsToApi maps the role assignments of member, for other
+// resources granting the same roles.
+func RoleAssignmentsToApi(ctx context.Context, member OrganizationMember, organizationID string, diagnostics *diag.Diagnostics) *models.RoleAssignments {
+	apiModel := modelToApi(ctx, member, organizationID, diagnostics)
+	if apiModel == nil {
+		return nil
+	}
+	return apiModel.RoleAssignments
+}
+
 func modelToApi(ctx context.Context, m OrganizationMember, organizationID string, diagnostics *diag.Diagnostics) *models.OrganizationMembership {
 	// org
 	var apiOrgRoleAssignments []*models.OrganizationRoleAssignment
@@ -62,6 +62,18 @@ func apiToModel(ctx context.Context, member models.OrganizationMembership, invit
 	}
 }
 
+// MemberToModel maps a member who joined the organization, e.g. for the
+// organization data source.
+func MemberToModel(ctx context.Context, member models.OrganizationMembership, diagnostics *diag.Diagnostics) *OrganizationMember {
+	return apiToModel(ctx, member, false, diagnostics)
+}
+
+// InvitationToModel maps a pending invitation to join the organization, e.g.
+// for the organization data source.
+func InvitationToModel(ctx context.Context, invitation *models.OrganizationInvitation, diagnostics *diag.Diagnostics) *OrganizationMember {
+	return invitationToModel(ctx, invitation, nil, diagnostics)
+}
+
 func organizationRoleApiToModel(member models.OrganizationMembership) types.String {
 	if member.RoleAssignments != nil &&
 		len(member.RoleAssignments.Organization) > 0 &&
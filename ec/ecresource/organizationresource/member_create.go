@@ -0,0 +1,74 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package organizationresource
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func (r *MemberResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	diagnostics := &response.Diagnostics
+	if !r.ready(diagnostics) {
+		return
+	}
+
+	var plan OrganizationMemberResourceModel
+	diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if diagnostics.HasError() {
+		return
+	}
+
+	organizationID := r.organizationID(plan.OrganizationID, diagnostics)
+	if diagnostics.HasError() {
+		return
+	}
+	email := plan.Email.ValueString()
+
+	// Only look the member up, an expired invitation mustn't be renewed
+	// when the member can't be created.
+	member, invitation := r.findMember(organizationID, email, diagnostics)
+	if diagnostics.HasError() {
+		return
+	}
+	if member != nil || invitation != nil {
+		diagnostics.Append(diag.NewErrorDiagnostic(
+			"Organization member already exists",
+			fmt.Sprintf("%s is already a member of organization %s, please import the member using terraform import.", email, organizationID),
+		))
+		return
+	}
+
+	r.organization.createInvitation(ctx, email, plan.OrganizationMember, organizationID, plan.expiresIn(), diagnostics)
+	if diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = types.StringValue(email)
+	plan.OrganizationID = types.StringValue(organizationID)
+	r.refresh(ctx, &plan, nil, diagnostics)
+	if diagnostics.HasError() {
+		return
+	}
+
+	diagnostics.Append(response.State.Set(ctx, plan)...)
+}
@@ -0,0 +1,51 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package organizationresource
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ImportState imports a member by email, or by organization ID and email
+// separated by a comma.
+func (r *MemberResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	if !r.ready(&response.Diagnostics) {
+		return
+	}
+
+	organizationID := types.StringNull()
+	email := request.ID
+	if before, after, ok := strings.Cut(request.ID, ","); ok {
+		organizationID = types.StringValue(before)
+		email = after
+	}
+
+	id := r.organizationID(organizationID, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("id"), email)...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("email"), email)...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("organization_id"), id)...)
+}
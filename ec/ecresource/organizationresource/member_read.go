@@ -0,0 +1,130 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package organizationresource
+
+import (
+	"context"
+	"strings"
+
+	"github.com/elastic/cloud-sdk-go/pkg/api/organizationapi"
+	"github.com/elastic/cloud-sdk-go/pkg/models"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func (r *MemberResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	diagnostics := &response.Diagnostics
+	if !r.ready(diagnostics) {
+		return
+	}
+
+	var state OrganizationMemberResourceModel
+	diagnostics.Append(request.State.Get(ctx, &state)...)
+	if diagnostics.HasError() {
+		return
+	}
+
+	state.InvitationSettings = state.InvitationSettings.withDefaults()
+	member := r.readMember(ctx, state.OrganizationID.ValueString(), state.Email.ValueString(), state.expiresIn(), &state.OrganizationMember, diagnostics)
+	if diagnostics.HasError() {
+		return
+	}
+	if member == nil {
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	state.OrganizationMember = *member
+	diagnostics.Append(response.State.Set(ctx, state)...)
+}
+
+// readMember returns the member of the organization with the given email,
+// either a user who joined the organization or a pending invitation. It
+// returns nil when there is no such member. An expired invitation is renewed
+// with expiresIn, previous is the member as it was known before, if any.
+func (r *MemberResource) readMember(
+	ctx context.Context,
+	organizationID, email, expiresIn string,
+	previous *OrganizationMember,
+	diagnostics *diag.Diagnostics,
+) *OrganizationMember {
+	member, invitation := r.findMember(organizationID, email, diagnostics)
+	switch {
+	case member != nil:
+		return apiToModel(ctx, *member, false, diagnostics)
+	case invitation != nil:
+		return r.organization.readInvitation(ctx, invitation, expiresIn, previous, diagnostics)
+	default:
+		return nil
+	}
+}
+
+// findMember looks up the user who joined the organization with the given
+// email or, if there is none, the invitation sent to it. Unlike readMember,
+// it doesn't change anything, expired invitations are returned as they are.
+func (r *MemberResource) findMember(
+	organizationID, email string,
+	diagnostics *diag.Diagnostics,
+) (*models.OrganizationMembership, *models.OrganizationInvitation) {
+	members, err := organizationapi.ListMembers(organizationapi.ListMembersParams{
+		API:            r.organization.client,
+		OrganizationID: organizationID,
+	})
+	if err != nil {
+		diagnostics.Append(diag.NewErrorDiagnostic("Listing organization members failed", err.Error()))
+		return nil, nil
+	}
+
+	for _, member := range members.Members {
+		if strings.EqualFold(member.Email, email) {
+			return member, nil
+		}
+	}
+
+	// Members that were invited, but have not yet accepted, are listed as invitations
+	invitations, err := organizationapi.ListInvitations(organizationapi.ListInvitationsParams{
+		API:            r.organization.client,
+		OrganizationID: organizationID,
+	})
+	if err != nil {
+		diagnostics.Append(diag.NewErrorDiagnostic("Listing organization members failed", err.Error()))
+		return nil, nil
+	}
+
+	for _, invitation := range invitations.Invitations {
+		if invitation.Email != nil && strings.EqualFold(*invitation.Email, email) {
+			return nil, invitation
+		}
+	}
+
+	return nil, nil
+}
+
+// refresh reads the member back from the API once it has been changed.
+// previous is the member as it was known before the change, if any.
+func (r *MemberResource) refresh(ctx context.Context, state *OrganizationMemberResourceModel, previous *OrganizationMember, diagnostics *diag.Diagnostics) {
+	member := r.readMember(ctx, state.OrganizationID.ValueString(), state.Email.ValueString(), state.expiresIn(), previous, diagnostics)
+	if diagnostics.HasError() {
+		return
+	}
+	if member == nil {
+		diagnostics.Append(diag.NewErrorDiagnostic("Organization member not found", "The member could not be read back after being changed."))
+		return
+	}
+	state.OrganizationMember = *member
+}
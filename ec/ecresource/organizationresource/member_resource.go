@@ -0,0 +1,127 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package organizationresource
+
+import (
+	"context"
+
+	"github.com/elastic/cloud-sdk-go/pkg/api/organizationapi"
+	"github.com/elastic/terraform-provider-ec/ec/internal"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// MemberResource manages a single member of an organization, so that the
+// members can be split across configurations. It shares the member model and
+// API mappings of the organization resource.
+type MemberResource struct {
+	organization Resource
+}
+
+var _ resource.Resource = &MemberResource{}
+var _ resource.ResourceWithConfigure = &MemberResource{}
+var _ resource.ResourceWithImportState = &MemberResource{}
+var _ resource.ResourceWithModifyPlan = &MemberResource{}
+
+type OrganizationMemberResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	OrganizationID types.String `tfsdk:"organization_id"`
+	OrganizationMember
+	InvitationSettings
+}
+
+func (r *MemberResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_organization_member"
+}
+
+func (r *MemberResource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	client, diags := internal.ConvertProviderData(request.ProviderData)
+	response.Diagnostics.Append(diags...)
+	r.organization.client = client.Stateful
+	r.organization.serverlessClient = client.Serverless
+}
+
+func (r *MemberResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := withAttributes(organizationMembersSchema().NestedObject.Attributes, invitationSettingsAttributes())
+	attributes["id"] = schema.StringAttribute{
+		MarkdownDescription: "Unique identifier of this resource, the email of the member.",
+		Computed:            true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+	attributes["organization_id"] = schema.StringAttribute{
+		MarkdownDescription: "ID of the organization. Defaults to the organization of the API key.",
+		Optional:            true,
+		Computed:            true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+	attributes["email"] = schema.StringAttribute{
+		MarkdownDescription: "Email address of the member. A member who isn't part of the organization yet is invited to join it.",
+		Required:            true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Manages a single member of an Elastic Cloud organization.
+
+  ~> **This resource can only be used with Elastic Cloud SaaS**
+
+  ~> **Note on organization members** A member must not be managed by both this resource and the ` + "`members`" + ` attribute of the ec_organization resource, otherwise both resources will try to revert the changes of the other one.`,
+		Attributes: attributes,
+	}
+}
+
+func (r *MemberResource) ready(diags *diag.Diagnostics) bool {
+	if r.organization.client == nil {
+		diags.AddError(
+			"Unconfigured API Client",
+			"Expected configured API client. Please report this issue to the provider developers.",
+		)
+		return false
+	}
+	return true
+}
+
+// organizationID returns the configured organization ID, or the ID of the
+// organization of the API key when it isn't set.
+func (r *MemberResource) organizationID(value types.String, diagnostics *diag.Diagnostics) string {
+	if !value.IsNull() && !value.IsUnknown() {
+		return value.ValueString()
+	}
+
+	organizations, err := organizationapi.List(organizationapi.ListParams{API: r.organization.client})
+	if err != nil {
+		diagnostics.Append(diag.NewErrorDiagnostic("Listing organizations failed", err.Error()))
+		return ""
+	}
+	if len(organizations) == 0 || organizations[0].ID == nil {
+		diagnostics.Append(diag.NewErrorDiagnostic("Organization not found", "The API key doesn't belong to any organization, set organization_id."))
+		return ""
+	}
+	return *organizations[0].ID
+}
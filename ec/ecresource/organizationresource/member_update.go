@@ -0,0 +1,56 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package organizationresource
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func (r *MemberResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	diagnostics := &response.Diagnostics
+	if !r.ready(diagnostics) {
+		return
+	}
+
+	var plan OrganizationMemberResourceModel
+	var state OrganizationMemberResourceModel
+	diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	diagnostics.Append(request.State.Get(ctx, &state)...)
+	if diagnostics.HasError() {
+		return
+	}
+
+	// Only the invitation settings may have changed, which apply to the next
+	// invitations.
+	if rolesChanged(state.OrganizationMember, plan.OrganizationMember) {
+		organizationID := state.OrganizationID.ValueString()
+		r.organization.updateMember(ctx, state.Email.ValueString(), state.OrganizationMember, plan.OrganizationMember, organizationID, plan.expiresIn(), diagnostics)
+		if diagnostics.HasError() {
+			return
+		}
+	}
+
+	r.refresh(ctx, &plan, &state.OrganizationMember, diagnostics)
+	if diagnostics.HasError() {
+		return
+	}
+
+	diagnostics.Append(response.State.Set(ctx, plan)...)
+}
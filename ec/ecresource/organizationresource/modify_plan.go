@@ -0,0 +1,236 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package organizationresource
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/elastic/terraform-provider-ec/ec/internal/util"
+)
+
+// listProjectRolesFunc returns the names of all roles (built-in and custom)
+// available in the serverless project with the given type and ID.
+type listProjectRolesFunc func(ctx context.Context, projectType, projectID string) ([]string, error)
+
+func (r *Resource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	// Nothing to validate on destroy.
+	if request.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state Organization
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !request.State.Raw.IsNull() {
+		response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		response.Diagnostics.Append(planInvitations(ctx, state, &plan)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+		response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("members"), plan.Members)...)
+	}
+
+	// The role assignments can only be validated against the APIs when available.
+	if r.client == nil {
+		return
+	}
+	var lookupProject lookupProjectFunc
+	if r.serverlessClient != nil {
+		lookupProject = r.lookupProject
+	}
+
+	response.Diagnostics.Append(validateResourceIDs(ctx, plan, state, r.lookupDeployments, lookupProject)...)
+	if response.Diagnostics.HasError() || r.serverlessClient == nil {
+		return
+	}
+
+	response.Diagnostics.Append(validateApplicationRoles(ctx, plan, r.listProjectRoles)...)
+}
+
+// planInvitations reports the members of the state who haven't accepted
+// their invitation in time and plans the invitations of the members.
+func planInvitations(ctx context.Context, state Organization, plan *Organization) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if plan.Members.IsUnknown() {
+		return diags
+	}
+
+	stateMembers := membersByEmail(ctx, state.Members, &diags)
+	planMembers := membersByEmail(ctx, plan.Members, &diags)
+	if diags.HasError() || len(planMembers) == 0 {
+		return diags
+	}
+
+	for email, planMember := range planMembers {
+		stateMember, ok := stateMembers[email]
+		if !ok {
+			continue
+		}
+		diags.Append(checkPendingInvitation(stateMember, plan.InvitationSettings, path.Root("members").AtMapKey(email))...)
+		planInvitation(stateMember, &planMember)
+		planMembers[email] = planMember
+	}
+
+	members, d := types.MapValueFrom(ctx, organizationMembersSchema().NestedObject.GetAttributes().Type(), planMembers)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+	plan.Members = members
+	return diags
+}
+
+func (r *Resource) listProjectRoles(ctx context.Context, projectType, projectID string) ([]string, error) {
+	customRoles, err := util.ListCustomProjectRoles(ctx, r.serverlessClient, projectType, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	roles := slices.Clone(util.BuiltInProjectRoles[projectType])
+	for _, role := range customRoles {
+		roles = append(roles, role.Name)
+	}
+	return roles, nil
+}
+
+// validateApplicationRoles checks that the application roles of each project role assignment
+// exist in every project the assignment is scoped to. Assignments applying to all projects
+// cannot be resolved to a list of projects and are not validated.
+func validateApplicationRoles(ctx context.Context, plan Organization, listRoles listProjectRolesFunc) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if plan.Members.IsNull() || plan.Members.IsUnknown() {
+		return diags
+	}
+
+	var members map[string]OrganizationMember
+	diags.Append(plan.Members.ElementsAs(ctx, &members, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	// Roles are cached per project, as the same project is usually referenced by several members.
+	type projectKey struct{ projectType, projectID string }
+	rolesByProject := make(map[projectKey][]string)
+	failedProjects := make(map[projectKey]bool)
+
+	emails := make([]string, 0, len(members))
+	for email := range members {
+		emails = append(emails, email)
+	}
+	sort.Strings(emails)
+
+	for _, email := range emails {
+		member := members[email]
+		for _, assignments := range []struct {
+			attribute   string
+			projectType string
+			value       types.Set
+		}{
+			{"project_elasticsearch_roles", "elasticsearch", member.ProjectElasticsearchRoles},
+			{"project_observability_roles", "observability", member.ProjectObservabilityRoles},
+			{"project_security_roles", "security", member.ProjectSecurityRoles},
+		} {
+			if assignments.value.IsNull() || assignments.value.IsUnknown() {
+				continue
+			}
+
+			var roleAssignments []ProjectRoleAssignment
+			if d := assignments.value.ElementsAs(ctx, &roleAssignments, false); d.HasError() {
+				diags.Append(d...)
+				return diags
+			}
+
+			attributePath := path.Root("members").AtMapKey(email).AtName(assignments.attribute)
+			for _, assignment := range roleAssignments {
+				applicationRoles, ok := knownStrings(ctx, assignment.ApplicationRoles)
+				if !ok || len(applicationRoles) == 0 {
+					continue
+				}
+				projectIDs, ok := knownStrings(ctx, assignment.ProjectIDs)
+				if !ok {
+					continue
+				}
+
+				for _, projectID := range projectIDs {
+					key := projectKey{assignments.projectType, projectID}
+					if failedProjects[key] {
+						continue
+					}
+
+					roles, cached := rolesByProject[key]
+					if !cached {
+						var err error
+						roles, err = listRoles(ctx, assignments.projectType, projectID)
+						if err != nil {
+							failedProjects[key] = true
+							diags.AddAttributeWarning(
+								attributePath,
+								"Unable to validate application roles",
+								fmt.Sprintf("Failed to retrieve the roles of %s project %s, its application roles will not be validated: %s", assignments.projectType, projectID, err),
+							)
+							continue
+						}
+						rolesByProject[key] = roles
+					}
+
+					for _, role := range applicationRoles {
+						if !slices.Contains(roles, role) {
+							diags.AddAttributeError(
+								attributePath,
+								"Invalid application role",
+								fmt.Sprintf("Role %q does not exist in %s project %s. Available roles: %s", role, assignments.projectType, projectID, strings.Join(roles, ", ")),
+							)
+						}
+					}
+				}
+			}
+		}
+	}
+
+	return diags
+}
+
+// knownStrings returns the sorted elements of the given set, or false if the set or any of its elements is unknown.
+func knownStrings(ctx context.Context, set types.Set) ([]string, bool) {
+	if set.IsUnknown() {
+		return nil, false
+	}
+
+	var values []string
+	if set.ElementsAs(ctx, &values, false).HasError() {
+		return nil, false
+	}
+	sort.Strings(values)
+	return values, true
+}
@@ -0,0 +1,147 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package organizationresource
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/elastic/cloud-sdk-go/pkg/models"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateApplicationRoles(t *testing.T) {
+	member := func(roles *models.ProjectRoleAssignments) models.OrganizationMembership {
+		return models.OrganizationMembership{
+			Email:           "user@example.com",
+			RoleAssignments: &models.RoleAssignments{Project: roles},
+		}
+	}
+	elasticsearchRolesPath := path.Root("members").AtMapKey("user@example.com").AtName("project_elasticsearch_roles")
+
+	tests := []struct {
+		name          string
+		member        models.OrganizationMembership
+		roles         map[string][]string
+		expectedDiags diag.Diagnostics
+		expectedCalls int
+	}{
+		{
+			name: "assignments without application roles are not validated",
+			member: member(&models.ProjectRoleAssignments{
+				Elasticsearch: []*models.ProjectRoleAssignment{
+					{RoleID: new("elasticsearch-viewer"), ProjectIds: []string{"es1"}},
+				},
+			}),
+		},
+		{
+			name: "assignments for all projects are not validated",
+			member: member(&models.ProjectRoleAssignments{
+				Elasticsearch: []*models.ProjectRoleAssignment{
+					{RoleID: new("elasticsearch-viewer"), All: new(true), ApplicationRoles: []string{"custom"}},
+				},
+			}),
+		},
+		{
+			name: "existing application roles are valid",
+			member: member(&models.ProjectRoleAssignments{
+				Elasticsearch: []*models.ProjectRoleAssignment{
+					{RoleID: new("elasticsearch-viewer"), ProjectIds: []string{"es1", "es2"}, ApplicationRoles: []string{"custom"}},
+					{RoleID: new("elasticsearch-admin"), ProjectIds: []string{"es1"}, ApplicationRoles: []string{"admin"}},
+				},
+			}),
+			roles: map[string][]string{
+				"elasticsearch/es1": {"admin", "custom"},
+				"elasticsearch/es2": {"custom"},
+			},
+			expectedCalls: 2,
+		},
+		{
+			name: "unknown application roles are reported on the role assignments",
+			member: member(&models.ProjectRoleAssignments{
+				Elasticsearch: []*models.ProjectRoleAssignment{
+					{RoleID: new("elasticsearch-viewer"), ProjectIds: []string{"es1"}, ApplicationRoles: []string{"missing"}},
+				},
+				Security: []*models.ProjectRoleAssignment{
+					{RoleID: new("security-viewer"), ProjectIds: []string{"sec1"}, ApplicationRoles: []string{"custom"}},
+				},
+			}),
+			roles: map[string][]string{
+				"elasticsearch/es1": {"admin", "custom"},
+				"security/sec1":     {"custom"},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					elasticsearchRolesPath,
+					"Invalid application role",
+					`Role "missing" does not exist in elasticsearch project es1. Available roles: admin, custom`,
+				),
+			},
+			expectedCalls: 2,
+		},
+		{
+			name: "roles which cannot be listed result in a warning",
+			member: member(&models.ProjectRoleAssignments{
+				Elasticsearch: []*models.ProjectRoleAssignment{
+					{RoleID: new("elasticsearch-viewer"), ProjectIds: []string{"unknown"}, ApplicationRoles: []string{"custom"}},
+				},
+			}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeWarningDiagnostic(
+					elasticsearchRolesPath,
+					"Unable to validate application roles",
+					"Failed to retrieve the roles of elasticsearch project unknown, its application roles will not be validated: not found",
+				),
+			},
+			expectedCalls: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			var diags diag.Diagnostics
+			member := apiToModel(ctx, tt.member, false, &diags)
+			require.False(t, diags.HasError())
+
+			members, d := types.MapValueFrom(ctx, organizationMembersSchema().NestedObject.Type(), map[string]OrganizationMember{
+				member.Email.ValueString(): *member,
+			})
+			require.False(t, d.HasError())
+
+			calls := 0
+			listRoles := func(_ context.Context, projectType, projectID string) ([]string, error) {
+				calls++
+				roles, ok := tt.roles[projectType+"/"+projectID]
+				if !ok {
+					return nil, errors.New("not found")
+				}
+				return roles, nil
+			}
+
+			diags = validateApplicationRoles(ctx, Organization{ID: types.StringValue("org"), Members: members}, listRoles)
+			require.Equal(t, tt.expectedDiags, diags)
+			require.Equal(t, tt.expectedCalls, calls)
+		})
+	}
+}
@@ -20,9 +20,7 @@ package organizationresource
 import (
 	"context"
 	"github.com/elastic/cloud-sdk-go/pkg/api/organizationapi"
-	"github.com/elastic/cloud-sdk-go/pkg/models"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -30,13 +28,18 @@ import (
 func (r *Resource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
 	diagnostics := &response.Diagnostics
 
-	var organizationID string
-	diagnostics.Append(request.State.GetAttribute(ctx, path.Root("id"), &organizationID)...)
+	var state Organization
+	diagnostics.Append(request.State.Get(ctx, &state)...)
 	if diagnostics.HasError() {
 		return
 	}
 
-	organization := r.readFromApi(ctx, organizationID, diagnostics)
+	previous := membersByEmail(ctx, state.Members, diagnostics)
+	if diagnostics.HasError() {
+		return
+	}
+
+	organization := r.readFromApi(ctx, state.ID.ValueString(), state.InvitationSettings.withDefaults(), previous, diagnostics)
 	if diagnostics.HasError() {
 		return
 	}
@@ -44,7 +47,9 @@ func (r *Resource) Read(ctx context.Context, request resource.ReadRequest, respo
 	diagnostics.Append(response.State.Set(ctx, organization)...)
 }
 
-func (r *Resource) readFromApi(ctx context.Context, organizationID string, diagnostics *diag.Diagnostics) *Organization {
+// readFromApi reads the members of the organization, renewing the expired
+// invitations. previous holds the members known before, by email.
+func (r *Resource) readFromApi(ctx context.Context, organizationID string, settings InvitationSettings, previous map[string]OrganizationMember, diagnostics *diag.Diagnostics) *Organization {
 	members, err := organizationapi.ListMembers(organizationapi.ListMembersParams{
 		API:            r.client,
 		OrganizationID: organizationID,
@@ -74,11 +79,11 @@ func (r *Resource) readFromApi(ctx context.Context, organizationID string, diagn
 	}
 
 	for _, invitation := range invitations.Invitations {
-		model := apiToModel(ctx, models.OrganizationMembership{
-			Email:           *invitation.Email,
-			OrganizationID:  invitation.Organization.ID,
-			RoleAssignments: invitation.RoleAssignments,
-		}, true, diagnostics)
+		var previousMember *OrganizationMember
+		if member, ok := previous[*invitation.Email]; ok {
+			previousMember = &member
+		}
+		model := r.readInvitation(ctx, invitation, settings.expiresIn(), previousMember, diagnostics)
 		if diagnostics.HasError() {
 			return nil
 		}
@@ -92,7 +97,18 @@ func (r *Resource) readFromApi(ctx context.Context, organizationID string, diagn
 	}
 
 	return &Organization{
-		ID:      types.StringValue(organizationID),
-		Members: membersMapValue,
+		ID:                 types.StringValue(organizationID),
+		Members:            membersMapValue,
+		InvitationSettings: settings,
+	}
+}
+
+// membersByEmail returns the members of the organization in the state.
+func membersByEmail(ctx context.Context, members types.Map, diagnostics *diag.Diagnostics) map[string]OrganizationMember {
+	result := make(map[string]OrganizationMember)
+	if members.IsNull() || members.IsUnknown() {
+		return result
 	}
+	diagnostics.Append(members.ElementsAs(ctx, &result, false)...)
+	return result
 }
@@ -21,16 +21,19 @@ import (
 	"context"
 	"github.com/elastic/cloud-sdk-go/pkg/api"
 	"github.com/elastic/terraform-provider-ec/ec/internal"
+	"github.com/elastic/terraform-provider-ec/ec/internal/gen/serverless"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 )
 
 type Resource struct {
-	client *api.API
+	client           *api.API
+	serverlessClient serverless.ClientWithResponsesInterface
 }
 
 var _ resource.Resource = &Resource{}
 var _ resource.ResourceWithConfigure = &Resource{}
 var _ resource.ResourceWithImportState = &Resource{}
+var _ resource.ResourceWithModifyPlan = &Resource{}
 
 func (r *Resource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
 	response.TypeName = request.ProviderTypeName + "_organization"
@@ -40,4 +43,5 @@ func (r *Resource) Configure(ctx context.Context, request resource.ConfigureRequ
 	client, diags := internal.ConvertProviderData(request.ProviderData)
 	response.Diagnostics.Append(diags...)
 	r.client = client.Stateful
+	r.serverlessClient = client.Serverless
 }
@@ -0,0 +1,319 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package organizationresource
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"sort"
+
+	"github.com/elastic/cloud-sdk-go/pkg/api/deploymentapi"
+	"github.com/elastic/cloud-sdk-go/pkg/models"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// resourceStatus tells whether a deployment or project referenced by a role
+// assignment exists.
+type resourceStatus int
+
+const (
+	resourceNotFound resourceStatus = iota
+	resourceExists
+	resourceDeleted
+)
+
+// lookupDeploymentsFunc returns the status of the deployments with the given
+// IDs. Deployments missing from the result were not found.
+type lookupDeploymentsFunc func(ctx context.Context, ids []string) (map[string]resourceStatus, error)
+
+// lookupProjectFunc returns the status of the serverless project with the
+// given type and ID.
+type lookupProjectFunc func(ctx context.Context, projectType, projectID string) (resourceStatus, error)
+
+func (r *Resource) lookupDeployments(ctx context.Context, ids []string) (map[string]resourceStatus, error) {
+	res, err := deploymentapi.Search(deploymentapi.SearchParams{
+		API:     r.client,
+		Request: deploymentsSearchRequest(ids),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]resourceStatus, len(res.Deployments))
+	for _, deployment := range res.Deployments {
+		if deployment.ID == nil {
+			continue
+		}
+		// Terminated deployments are hidden, stopped ones still exist and can
+		// be assigned roles.
+		if deployment.Metadata != nil && deployment.Metadata.Hidden != nil && *deployment.Metadata.Hidden {
+			result[*deployment.ID] = resourceDeleted
+		} else {
+			result[*deployment.ID] = resourceExists
+		}
+	}
+	return result, nil
+}
+
+// deploymentsSearchRequest searches the deployments with the given IDs.
+func deploymentsSearchRequest(ids []string) *models.SearchRequest {
+	terms := make([]*models.QueryContainer, 0, len(ids))
+	for _, id := range ids {
+		terms = append(terms, &models.QueryContainer{
+			Term: map[string]models.TermQuery{
+				"id": {Value: &id},
+			},
+		})
+	}
+
+	return &models.SearchRequest{
+		Size: int32(len(ids)),
+		Sort: []any{"id"},
+		Query: &models.QueryContainer{
+			Bool: &models.BoolQuery{
+				MinimumShouldMatch: 1,
+				Should:             terms,
+			},
+		},
+	}
+}
+
+func (r *Resource) lookupProject(ctx context.Context, projectType, projectID string) (resourceStatus, error) {
+	var (
+		found      bool
+		statusCode int
+		status     string
+		body       []byte
+	)
+
+	switch projectType {
+	case "elasticsearch":
+		resp, err := r.serverlessClient.GetElasticsearchProjectWithResponse(ctx, projectID)
+		if err != nil {
+			return resourceNotFound, err
+		}
+		found, statusCode, status, body = resp.JSON200 != nil, resp.StatusCode(), resp.Status(), resp.Body
+	case "observability":
+		resp, err := r.serverlessClient.GetObservabilityProjectWithResponse(ctx, projectID)
+		if err != nil {
+			return resourceNotFound, err
+		}
+		found, statusCode, status, body = resp.JSON200 != nil, resp.StatusCode(), resp.Status(), resp.Body
+	case "security":
+		resp, err := r.serverlessClient.GetSecurityProjectWithResponse(ctx, projectID)
+		if err != nil {
+			return resourceNotFound, err
+		}
+		found, statusCode, status, body = resp.JSON200 != nil, resp.StatusCode(), resp.Status(), resp.Body
+	default:
+		return resourceNotFound, fmt.Errorf("unsupported project type %q", projectType)
+	}
+
+	switch {
+	case found:
+		return resourceExists, nil
+	case statusCode == http.StatusNotFound:
+		return resourceNotFound, nil
+	default:
+		return resourceNotFound, fmt.Errorf("the API request failed with: %d %s\n%s", statusCode, status, body)
+	}
+}
+
+// resourceReference is a deployment or project ID listed in a role assignment.
+type resourceReference struct {
+	email     string
+	attribute path.Path
+	kind      string // "deployment" or the project type
+	id        string
+}
+
+// validateResourceIDs checks that the deployments and projects the plan adds
+// to the role assignments of the members exist. IDs the state already
+// assigns to the member are not looked up again, so that planning an
+// unchanged organization doesn't query the APIs. An ID which doesn't exist is
+// an error, unless the state references it: the deployment or project was
+// then deleted since it was assigned, which is a warning so that the
+// assignment can still be fixed or removed. Project IDs are not validated
+// when lookupProject is nil.
+func validateResourceIDs(
+	ctx context.Context,
+	plan, state Organization,
+	lookupDeployments lookupDeploymentsFunc,
+	lookupProject lookupProjectFunc,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if plan.Members.IsNull() || plan.Members.IsUnknown() {
+		return diags
+	}
+
+	planned := resourceReferences(ctx, plan.Members, &diags)
+	current := resourceReferences(ctx, state.Members, &diags)
+	if diags.HasError() {
+		return diags
+	}
+
+	assigned := make(map[string]bool)
+	for _, reference := range current {
+		assigned[reference.kind+"/"+reference.id] = true
+	}
+
+	var references []resourceReference
+	for _, reference := range planned {
+		if !slices.ContainsFunc(current, func(r resourceReference) bool {
+			return r.email == reference.email && r.kind == reference.kind && r.id == reference.id
+		}) {
+			references = append(references, reference)
+		}
+	}
+
+	statuses := make(map[string]resourceStatus)
+	failed := make(map[string]error)
+
+	var deploymentIDs []string
+	for _, reference := range references {
+		if reference.kind == "deployment" && !slices.Contains(deploymentIDs, reference.id) {
+			deploymentIDs = append(deploymentIDs, reference.id)
+		}
+	}
+	if len(deploymentIDs) > 0 {
+		sort.Strings(deploymentIDs)
+		found, err := lookupDeployments(ctx, deploymentIDs)
+		for _, id := range deploymentIDs {
+			if err != nil {
+				failed["deployment/"+id] = err
+				continue
+			}
+			statuses["deployment/"+id] = found[id]
+		}
+	}
+
+	for _, reference := range references {
+		key := reference.kind + "/" + reference.id
+		if reference.kind != "deployment" {
+			if lookupProject == nil {
+				continue
+			}
+			if _, ok := statuses[key]; !ok && failed[key] == nil {
+				status, err := lookupProject(ctx, reference.kind, reference.id)
+				if err != nil {
+					failed[key] = err
+				} else {
+					statuses[key] = status
+				}
+			}
+		}
+
+		name := reference.kind + " project"
+		if reference.kind == "deployment" {
+			name = "deployment"
+		}
+
+		if err := failed[key]; err != nil {
+			diags.AddAttributeWarning(
+				reference.attribute,
+				fmt.Sprintf("Unable to validate %s", name),
+				fmt.Sprintf("Failed to retrieve %s %s, it will not be validated: %s", name, reference.id, err),
+			)
+			continue
+		}
+
+		switch status := statuses[key]; {
+		case status == resourceDeleted || (status == resourceNotFound && assigned[key]):
+			diags.AddAttributeWarning(
+				reference.attribute,
+				fmt.Sprintf("Deleted %s", name),
+				fmt.Sprintf("The %s %s was deleted, remove it from the role assignment.", name, reference.id),
+			)
+		case status == resourceNotFound:
+			diags.AddAttributeError(
+				reference.attribute,
+				fmt.Sprintf("Unknown %s", name),
+				fmt.Sprintf("The %s %s does not exist.", name, reference.id),
+			)
+		}
+	}
+
+	return diags
+}
+
+// resourceReferences returns the deployment and project IDs of the role
+// assignments of members, sorted by member. Unknown IDs are skipped.
+func resourceReferences(ctx context.Context, members types.Map, diags *diag.Diagnostics) []resourceReference {
+	byEmail := membersByEmail(ctx, members, diags)
+	if diags.HasError() {
+		return nil
+	}
+
+	emails := make([]string, 0, len(byEmail))
+	for email := range byEmail {
+		emails = append(emails, email)
+	}
+	sort.Strings(emails)
+
+	var references []resourceReference
+	for _, email := range emails {
+		member := byEmail[email]
+		memberPath := path.Root("members").AtMapKey(email)
+
+		if !member.DeploymentRoles.IsNull() && !member.DeploymentRoles.IsUnknown() {
+			for _, element := range member.DeploymentRoles.Elements() {
+				var assignment DeploymentRoleAssignment
+				if d := tfsdk.ValueAs(ctx, element, &assignment); d.HasError() {
+					diags.Append(d...)
+					return nil
+				}
+				ids, _ := knownStrings(ctx, assignment.DeploymentIDs)
+				for _, id := range ids {
+					references = append(references, resourceReference{email, memberPath.AtName("deployment_roles").AtSetValue(element), "deployment", id})
+				}
+			}
+		}
+
+		for _, roles := range []struct {
+			attribute   string
+			projectType string
+			value       types.Set
+		}{
+			{"project_elasticsearch_roles", "elasticsearch", member.ProjectElasticsearchRoles},
+			{"project_observability_roles", "observability", member.ProjectObservabilityRoles},
+			{"project_security_roles", "security", member.ProjectSecurityRoles},
+		} {
+			if roles.value.IsNull() || roles.value.IsUnknown() {
+				continue
+			}
+
+			for _, element := range roles.value.Elements() {
+				var assignment ProjectRoleAssignment
+				if d := tfsdk.ValueAs(ctx, element, &assignment); d.HasError() {
+					diags.Append(d...)
+					return nil
+				}
+				ids, _ := knownStrings(ctx, assignment.ProjectIDs)
+				for _, id := range ids {
+					references = append(references, resourceReference{email, memberPath.AtName(roles.attribute).AtSetValue(element), roles.projectType, id})
+				}
+			}
+		}
+	}
+	return references
+}
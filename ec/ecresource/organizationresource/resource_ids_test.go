@@ -0,0 +1,230 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package organizationresource
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/elastic/cloud-sdk-go/pkg/api"
+	"github.com/elastic/cloud-sdk-go/pkg/api/mock"
+	"github.com/elastic/cloud-sdk-go/pkg/models"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateResourceIDs(t *testing.T) {
+	member := func(email string, deploymentIDs []string, projectIDs []string) models.OrganizationMembership {
+		return models.OrganizationMembership{
+			Email: email,
+			RoleAssignments: &models.RoleAssignments{
+				Deployment: []*models.DeploymentRoleAssignment{
+					{RoleID: new("deployment-editor"), DeploymentIds: deploymentIDs},
+				},
+				Project: &models.ProjectRoleAssignments{
+					Elasticsearch: []*models.ProjectRoleAssignment{
+						{RoleID: new("elasticsearch-viewer"), ProjectIds: projectIDs},
+					},
+				},
+			},
+		}
+	}
+	// assignmentPath returns the path of the role assignment of the membership
+	// with the given role.
+	assignmentPath := func(membership models.OrganizationMembership, attribute, role string) path.Path {
+		var diags diag.Diagnostics
+		model := apiToModel(context.Background(), membership, false, &diags)
+		require.False(t, diags.HasError())
+
+		assignments := map[string]types.Set{
+			"deployment_roles":            model.DeploymentRoles,
+			"project_elasticsearch_roles": model.ProjectElasticsearchRoles,
+		}[attribute]
+		for _, element := range assignments.Elements() {
+			if element.(types.Object).Attributes()["role"].Equal(types.StringValue(role)) {
+				return path.Root("members").AtMapKey(membership.Email).AtName(attribute).AtSetValue(element)
+			}
+		}
+		require.Failf(t, "missing role assignment", "%s has no %s assignment", attribute, role)
+		return path.Empty()
+	}
+
+	typos := member("user@example.com", []string{"d1", "typo"}, []string{"missing"})
+	typos.RoleAssignments.Deployment = append(typos.RoleAssignments.Deployment,
+		&models.DeploymentRoleAssignment{RoleID: new("deployment-viewer"), DeploymentIds: []string{"d2"}},
+	)
+	deleted := member("user@example.com", []string{"terminated"}, nil)
+	unvalidated := member("user@example.com", []string{"d1"}, nil)
+	reassigned := member("user@example.com", nil, []string{"es1"})
+
+	tests := []struct {
+		name                    string
+		plan                    []models.OrganizationMembership
+		state                   []models.OrganizationMembership
+		deployments             map[string]resourceStatus
+		deploymentsErr          error
+		projects                map[string]resourceStatus
+		skipProjects            bool
+		expectedDiags           diag.Diagnostics
+		expectedDeploymentCalls int
+		expectedProjectCalls    int
+	}{
+		{
+			name:                    "existing deployments and projects are valid",
+			plan:                    []models.OrganizationMembership{member("user@example.com", []string{"d1", "d2"}, []string{"es1"})},
+			deployments:             map[string]resourceStatus{"d1": resourceExists, "d2": resourceExists},
+			projects:                map[string]resourceStatus{"elasticsearch/es1": resourceExists},
+			expectedDeploymentCalls: 1,
+			expectedProjectCalls:    1,
+		},
+		{
+			name:  "IDs already assigned to the member are not looked up",
+			plan:  []models.OrganizationMembership{member("user@example.com", []string{"d1"}, []string{"es1"})},
+			state: []models.OrganizationMembership{member("user@example.com", []string{"d1"}, []string{"es1"})},
+		},
+		{
+			name:                    "unknown IDs are reported on the role assignments",
+			plan:                    []models.OrganizationMembership{typos},
+			deployments:             map[string]resourceStatus{"d1": resourceExists, "d2": resourceExists},
+			projects:                map[string]resourceStatus{},
+			expectedDeploymentCalls: 1,
+			expectedProjectCalls:    1,
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(assignmentPath(typos, "deployment_roles", "editor"), "Unknown deployment", "The deployment typo does not exist."),
+				diag.NewAttributeErrorDiagnostic(assignmentPath(typos, "project_elasticsearch_roles", "viewer"), "Unknown elasticsearch project", "The elasticsearch project missing does not exist."),
+			},
+		},
+		{
+			name:                    "deleted deployments result in a warning",
+			plan:                    []models.OrganizationMembership{deleted},
+			deployments:             map[string]resourceStatus{"terminated": resourceDeleted},
+			expectedDeploymentCalls: 1,
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeWarningDiagnostic(assignmentPath(deleted, "deployment_roles", "editor"), "Deleted deployment", "The deployment terminated was deleted, remove it from the role assignment."),
+			},
+		},
+		{
+			name: "missing IDs assigned in the state result in a warning",
+			plan: []models.OrganizationMembership{reassigned},
+			state: []models.OrganizationMembership{
+				member("other@example.com", nil, []string{"es1"}),
+			},
+			projects:             map[string]resourceStatus{},
+			expectedProjectCalls: 1,
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeWarningDiagnostic(assignmentPath(reassigned, "project_elasticsearch_roles", "viewer"), "Deleted elasticsearch project", "The elasticsearch project es1 was deleted, remove it from the role assignment."),
+			},
+		},
+		{
+			name:                    "deployments which cannot be searched result in a warning",
+			plan:                    []models.OrganizationMembership{unvalidated},
+			deploymentsErr:          errors.New("unavailable"),
+			expectedDeploymentCalls: 1,
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeWarningDiagnostic(assignmentPath(unvalidated, "deployment_roles", "editor"), "Unable to validate deployment", "Failed to retrieve deployment d1, it will not be validated: unavailable"),
+			},
+		},
+		{
+			name:         "projects are not validated without the serverless API",
+			plan:         []models.OrganizationMembership{member("user@example.com", nil, []string{"missing"})},
+			skipProjects: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			toModel := func(memberships []models.OrganizationMembership) Organization {
+				if memberships == nil {
+					return Organization{Members: types.MapNull(organizationMembersSchema().NestedObject.Type())}
+				}
+
+				var diags diag.Diagnostics
+				members := make(map[string]OrganizationMember)
+				for _, membership := range memberships {
+					members[membership.Email] = *apiToModel(ctx, membership, false, &diags)
+				}
+				require.False(t, diags.HasError())
+
+				value, d := types.MapValueFrom(ctx, organizationMembersSchema().NestedObject.Type(), members)
+				require.False(t, d.HasError())
+				return Organization{ID: types.StringValue("org"), Members: value}
+			}
+
+			deploymentCalls := 0
+			lookupDeployments := func(_ context.Context, ids []string) (map[string]resourceStatus, error) {
+				deploymentCalls++
+				return tt.deployments, tt.deploymentsErr
+			}
+
+			projectCalls := 0
+			var lookupProject lookupProjectFunc = func(_ context.Context, projectType, projectID string) (resourceStatus, error) {
+				projectCalls++
+				return tt.projects[projectType+"/"+projectID], nil
+			}
+			if tt.skipProjects {
+				lookupProject = nil
+			}
+
+			diags := validateResourceIDs(ctx, toModel(tt.plan), toModel(tt.state), lookupDeployments, lookupProject)
+			require.Equal(t, tt.expectedDiags, diags)
+			require.Equal(t, tt.expectedDeploymentCalls, deploymentCalls)
+			require.Equal(t, tt.expectedProjectCalls, projectCalls)
+		})
+	}
+}
+
+func TestLookupDeployments(t *testing.T) {
+	deployment := func(id, status string, hidden bool) *models.DeploymentSearchResponse {
+		return &models.DeploymentSearchResponse{
+			ID:       new(id),
+			Metadata: &models.DeploymentMetadata{Hidden: new(hidden)},
+			Resources: &models.DeploymentResources{
+				Elasticsearch: []*models.ElasticsearchResourceInfo{
+					{Info: &models.ElasticsearchClusterInfo{Status: new(status)}},
+				},
+			},
+		}
+	}
+
+	r := &Resource{client: api.NewMock(
+		mock.New200Response(mock.NewStructBody(models.DeploymentsSearchResponse{
+			Deployments: []*models.DeploymentSearchResponse{
+				deployment("running", "started", false),
+				deployment("stopped", "stopped", false),
+				deployment("hidden", "started", true),
+			},
+		})),
+		mock.New500Response(mock.NewStringBody("{}")),
+	)}
+
+	statuses, err := r.lookupDeployments(context.Background(), []string{"hidden", "missing", "running", "stopped"})
+	require.NoError(t, err)
+	require.Equal(t, map[string]resourceStatus{
+		"running": resourceExists,
+		"stopped": resourceExists,
+		"hidden":  resourceDeleted,
+	}, statuses)
+
+	_, err = r.lookupDeployments(context.Background(), []string{"running"})
+	require.Error(t, err)
+}
@@ -317,6 +317,46 @@ func removeMemberFails() mock.Response {
 	)
 }
 
+func searchDeployments(ids ...string) mock.Response {
+	terms := make([]*models.QueryContainer, 0, len(ids))
+	deployments := make([]*models.DeploymentSearchResponse, 0, len(ids))
+	for _, id := range ids {
+		terms = append(terms, &models.QueryContainer{
+			Term: map[string]models.TermQuery{"id": {Value: new(id)}},
+		})
+		deployments = append(deployments, &models.DeploymentSearchResponse{
+			ID: new(id),
+			Resources: &models.DeploymentResources{
+				Elasticsearch: []*models.ElasticsearchResourceInfo{
+					{Info: &models.ElasticsearchClusterInfo{Status: new("started")}},
+				},
+			},
+		})
+	}
+
+	return mock.New200ResponseAssertion(
+		&mock.RequestAssertion{
+			Host:   api.DefaultMockHost,
+			Header: api.DefaultWriteMockHeaders,
+			Method: "POST",
+			Path:   "/api/v1/deployments/_search",
+			Body: mock.NewStructBody(models.SearchRequest{
+				Size: int32(len(ids)),
+				Sort: []any{"id"},
+				Query: &models.QueryContainer{
+					Bool: &models.BoolQuery{
+						MinimumShouldMatch: 1,
+						Should:             terms,
+					},
+				},
+			}),
+		},
+		mock.NewStructBody(models.DeploymentsSearchResponse{
+			Deployments: deployments,
+		}),
+	)
+}
+
 func buildExistingMember() *models.OrganizationMembership {
 	return &models.OrganizationMembership{
 		UserID:         new("userid"),
@@ -298,6 +298,8 @@ func Test(t *testing.T) {
 				// Apply
 				getMembers([]*models.OrganizationMembership{existingMember, newMember}),
 				getInvitations(nil),
+				searchDeployments("abc"),
+				searchDeployments("abc"),
 				addRoleAssignments(newMemberWithAddedRoles.RoleAssignments.Deployment),
 				getMembers([]*models.OrganizationMembership{existingMember, newMemberWithAddedRoles}),
 				getInvitations(nil),
@@ -387,6 +389,8 @@ func Test(t *testing.T) {
 				// Apply
 				getMembers([]*models.OrganizationMembership{existingMember, newMemberWithAddedRoles}),
 				getInvitations(nil),
+				searchDeployments("def"),
+				searchDeployments("def"),
 				removeRoleAssignments([]*models.DeploymentRoleAssignment{
 					newMemberWithAddedRoles.RoleAssignments.Deployment[0],
 				}, nil),
@@ -553,6 +557,8 @@ func Test(t *testing.T) {
 				// Apply
 				getMembers([]*models.OrganizationMembership{existingMember, newMember}),
 				getInvitations(nil),
+				searchDeployments("abc"),
+				searchDeployments("abc"),
 				addRoleAssignmentsFails(),
 			},
 		},
@@ -23,6 +23,7 @@ import (
 	"strings"
 
 	"github.com/elastic/terraform-provider-ec/ec/internal/planmodifiers"
+	"github.com/elastic/terraform-provider-ec/ec/internal/util"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
@@ -35,11 +36,14 @@ import (
 type Organization struct {
 	ID      types.String `tfsdk:"id"`
 	Members types.Map    `tfsdk:"members"` //< OrganizationMember
+	InvitationSettings
 }
 
 type OrganizationMember struct {
 	Email                     types.String `tfsdk:"email"`
 	InvitationPending         types.Bool   `tfsdk:"invitation_pending"`
+	InvitationExpiresAt       types.String `tfsdk:"invitation_expires_at"`
+	InvitedAt                 types.String `tfsdk:"invited_at"`
 	UserID                    types.String `tfsdk:"user_id"`
 	OrganizationRole          types.String `tfsdk:"organization_role"`
 	DeploymentRoles           types.Set    `tfsdk:"deployment_roles"`            //< DeploymentRoleAssignment
@@ -67,7 +71,7 @@ func (r *Resource) Schema(_ context.Context, _ resource.SchemaRequest, resp *res
 		MarkdownDescription: `Manages an Elastic Cloud organization membership.
 
   ~> **This resource can only be used with Elastic Cloud SaaS**`,
-		Attributes: map[string]schema.Attribute{
+		Attributes: withAttributes(map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				MarkdownDescription: "Organization ID",
 				Computed:            true,
@@ -76,7 +80,7 @@ func (r *Resource) Schema(_ context.Context, _ resource.SchemaRequest, resp *res
 				},
 			},
 			"members": organizationMembersSchema(),
-		},
+		}, invitationSettingsAttributes()),
 	}
 }
 
@@ -100,6 +104,20 @@ func organizationMembersSchema() schema.MapNestedAttribute {
 						planmodifiers.UseStateIfNotNullForUnknown(),
 					},
 				},
+				"invitation_expires_at": schema.StringAttribute{
+					MarkdownDescription: "Date and time (RFC 3339) when the pending invitation of the user expires. An expired invitation is sent again.",
+					Computed:            true,
+					PlanModifiers: []planmodifier.String{
+						planmodifiers.UseStateIfNotNullForUnknown(),
+					},
+				},
+				"invited_at": schema.StringAttribute{
+					MarkdownDescription: "Date and time (RFC 3339) when the user was first invited, while the invitation is pending.",
+					Computed:            true,
+					PlanModifiers: []planmodifier.String{
+						planmodifiers.UseStateIfNotNullForUnknown(),
+					},
+				},
 				"user_id": schema.StringAttribute{
 					MarkdownDescription: "User ID.",
 					Computed:            true,
@@ -120,6 +138,19 @@ func organizationMembersSchema() schema.MapNestedAttribute {
 	}
 }
 
+// RoleAssignmentAttributes returns the attributes of the role assignments of
+// a member, for other resources granting the same roles.
+func RoleAssignmentAttributes() map[string]schema.Attribute {
+	attributes := organizationMembersSchema().NestedObject.Attributes
+	return map[string]schema.Attribute{
+		"organization_role":           attributes["organization_role"],
+		"deployment_roles":            attributes["deployment_roles"],
+		"project_elasticsearch_roles": attributes["project_elasticsearch_roles"],
+		"project_observability_roles": attributes["project_observability_roles"],
+		"project_security_roles":      attributes["project_security_roles"],
+	}
+}
+
 func deploymentRoleAssignmentsSchema() schema.SetNestedAttribute {
 	return schema.SetNestedAttribute{
 		MarkdownDescription: "Grant access to one or more deployments. For more info see: [Deployment instance roles](https://www.elastic.co/guide/en/cloud/current/ec-user-privileges.html#ec_instance_access_roles).",
@@ -153,11 +184,7 @@ func deploymentRoleAssignmentsSchema() schema.SetNestedAttribute {
 }
 
 func projectElasticsearchRolesSchema() schema.SetNestedAttribute {
-	elementSchema := projectRoleAssignmentSchema([]string{
-		"admin",
-		"developer",
-		"viewer",
-	})
+	elementSchema := projectRoleAssignmentSchema(util.BuiltInProjectRoles["elasticsearch"])
 	return schema.SetNestedAttribute{
 		MarkdownDescription: "Roles assigned for elasticsearch projects. For more info see: [Serverless elasticsearch roles](https://www.elastic.co/docs/current/serverless/general/assign-user-roles#es) ",
 		Optional:            true,
@@ -170,11 +197,7 @@ func projectElasticsearchRolesSchema() schema.SetNestedAttribute {
 }
 
 func projectObservabilityRolesSchema() schema.SetNestedAttribute {
-	elementSchema := projectRoleAssignmentSchema([]string{
-		"admin",
-		"editor",
-		"viewer",
-	})
+	elementSchema := projectRoleAssignmentSchema(util.BuiltInProjectRoles["observability"])
 	return schema.SetNestedAttribute{
 		MarkdownDescription: "Roles assigned for observability projects. For more info see: [Serverless observability roles](https://www.elastic.co/docs/current/serverless/general/assign-user-roles#observability)",
 		Optional:            true,
@@ -187,21 +210,7 @@ func projectObservabilityRolesSchema() schema.SetNestedAttribute {
 }
 
 func projectSecurityRolesSchema() schema.SetNestedAttribute {
-	elementSchema := projectRoleAssignmentSchema([]string{
-		"admin",
-		"editor",
-		"viewer",
-		"t1-analyst",
-		"t2-analyst",
-		"t3-analyst",
-		"threat-intel-analyst",
-		"rule-author",
-		"soc-manager",
-		"endpoint-operations-analyst",
-		"platform-engineer",
-		"detections-admin",
-		"endpoint-policy-manager",
-	})
+	elementSchema := projectRoleAssignmentSchema(util.BuiltInProjectRoles["security"])
 	return schema.SetNestedAttribute{
 		MarkdownDescription: "Roles assigned for security projects. For more info see: [Serverless security roles](https://www.elastic.co/docs/current/serverless/general/assign-user-roles#security)",
 		Optional:            true,
@@ -233,7 +242,7 @@ func projectRoleAssignmentSchema(roles []string) schema.NestedAttributeObject {
 				ElementType:         types.StringType,
 			},
 			"application_roles": schema.SetAttribute{
-				MarkdownDescription: "If provided, the user assigned this role assignment will be granted this application role when signing in to the project(s) specified in the role assignment.",
+				MarkdownDescription: "If provided, the user assigned this role assignment will be granted this application role when signing in to the project(s) specified in the role assignment. The roles are validated against the built-in and custom roles of each project listed in `project_ids`, see the `ec_project_roles` data source.",
 				Optional:            true,
 				ElementType:         types.StringType,
 			},
@@ -43,6 +43,7 @@ func (r *Resource) Update(ctx context.Context, request resource.UpdateRequest, r
 	}
 
 	organizationID := plan.ID.ValueString()
+	expiresIn := plan.InvitationSettings.expiresIn()
 
 	planMembers := make(map[string]types.Object)
 	diags := plan.Members.ElementsAs(ctx, &planMembers, false)
@@ -67,7 +68,7 @@ func (r *Resource) Update(ctx context.Context, request resource.UpdateRequest, r
 		// create new invitation if member is in plan but not in state
 		stateMember, ok := stateMembers[email]
 		if !ok {
-			r.createInvitation(ctx, email, planMemberModel, organizationID, diagnostics)
+			r.createInvitation(ctx, email, planMemberModel, organizationID, expiresIn, diagnostics)
 		} else {
 			// member is in plan and state, update if there is a diff
 			if !stateMember.Equal(planMember) {
@@ -75,7 +76,7 @@ func (r *Resource) Update(ctx context.Context, request resource.UpdateRequest, r
 				if diagnostics.HasError() {
 					continue
 				}
-				r.updateMember(ctx, email, stateMemberModel, planMemberModel, organizationID, diagnostics)
+				r.updateMember(ctx, email, stateMemberModel, planMemberModel, organizationID, expiresIn, diagnostics)
 			}
 		}
 	}
@@ -94,7 +95,11 @@ func (r *Resource) Update(ctx context.Context, request resource.UpdateRequest, r
 	}
 
 	// Re-read the whole org from the API to get the current state
-	updatedOrganization := r.readFromApi(ctx, organizationID, diagnostics)
+	previous := membersByEmail(ctx, state.Members, diagnostics)
+	if diagnostics.HasError() {
+		return
+	}
+	updatedOrganization := r.readFromApi(ctx, organizationID, plan.InvitationSettings, previous, diagnostics)
 	if diagnostics.HasError() {
 		return
 	}
@@ -107,13 +112,14 @@ func (r *Resource) updateMember(
 	stateMember OrganizationMember,
 	planMember OrganizationMember,
 	organizationID string,
+	expiresIn string,
 	diagnostics *diag.Diagnostics,
 ) {
 	if planMember.InvitationPending.ValueBool() {
 		// Invitations can't be updated, so while the invitation is pending the role assignments can't be changed
 		// The only way to update them is by creating a new invitation with the right role-assignments.
 		r.deleteInvitation(email, organizationID, diagnostics)
-		r.createInvitation(ctx, email, planMember, organizationID, diagnostics)
+		r.createInvitation(ctx, email, planMember, organizationID, expiresIn, diagnostics)
 	} else {
 		// Add new role assignments
 		planApiMember := modelToApi(ctx, planMember, organizationID, diagnostics)
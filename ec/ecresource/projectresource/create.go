@@ -40,6 +40,9 @@ func (r *Resource[T]) Create(ctx context.Context, request resource.CreateRequest
 		return
 	}
 
+	ctx, cancel := context.WithTimeout(ctx, r.modelHandler.GetTimeouts(*model).create())
+	defer cancel()
+
 	createdModel, diags := r.api.Create(ctx, *model)
 	response.Diagnostics.Append(diags...)
 	if r.modelHandler.GetID(createdModel) != "" {
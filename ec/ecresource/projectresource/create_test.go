@@ -118,10 +118,11 @@ func TestCreate(t *testing.T) {
 
 				api := NewMockapi[resource_elasticsearch_project.ElasticsearchProjectModel](ctrl)
 				api.EXPECT().Ready().Return(true)
-				api.EXPECT().Create(ctx, readModel).Return(createdModel, createDiags)
+				api.EXPECT().Create(withDeadline(), readModel).Return(createdModel, createDiags)
 
 				handler := NewMockmodelHandler[resource_elasticsearch_project.ElasticsearchProjectModel](ctrl)
 				handler.EXPECT().ReadFrom(ctx, req.Plan).Return(&readModel, nil)
+				handler.EXPECT().GetTimeouts(readModel).Return(projectTimeouts{})
 				handler.EXPECT().GetID(createdModel).Return(createdModel.Id.ValueString())
 
 				return testData{
@@ -157,11 +158,12 @@ func TestCreate(t *testing.T) {
 
 				api := NewMockapi[resource_elasticsearch_project.ElasticsearchProjectModel](ctrl)
 				api.EXPECT().Ready().Return(true)
-				api.EXPECT().Create(ctx, readModel).Return(createdModel, nil)
-				api.EXPECT().EnsureInitialised(ctx, createdModel).Return(initDiags)
+				api.EXPECT().Create(withDeadline(), readModel).Return(createdModel, nil)
+				api.EXPECT().EnsureInitialised(withDeadline(), createdModel).Return(initDiags)
 
 				handler := NewMockmodelHandler[resource_elasticsearch_project.ElasticsearchProjectModel](ctrl)
 				handler.EXPECT().ReadFrom(ctx, req.Plan).Return(&readModel, nil)
+				handler.EXPECT().GetTimeouts(readModel).Return(projectTimeouts{})
 				handler.EXPECT().GetID(createdModel).Return(createdModel.Id.ValueString())
 
 				return testData{
@@ -197,12 +199,13 @@ func TestCreate(t *testing.T) {
 
 				api := NewMockapi[resource_elasticsearch_project.ElasticsearchProjectModel](ctrl)
 				api.EXPECT().Ready().Return(true)
-				api.EXPECT().Create(ctx, readModel).Return(createdModel, nil)
-				api.EXPECT().EnsureInitialised(ctx, createdModel).Return(nil)
-				api.EXPECT().Read(ctx, createdModel.Id.ValueString(), createdModel).Return(false, createdModel, readDiags)
+				api.EXPECT().Create(withDeadline(), readModel).Return(createdModel, nil)
+				api.EXPECT().EnsureInitialised(withDeadline(), createdModel).Return(nil)
+				api.EXPECT().Read(withDeadline(), createdModel.Id.ValueString(), createdModel).Return(false, createdModel, readDiags)
 
 				handler := NewMockmodelHandler[resource_elasticsearch_project.ElasticsearchProjectModel](ctrl)
 				handler.EXPECT().ReadFrom(ctx, req.Plan).Return(&readModel, nil)
+				handler.EXPECT().GetTimeouts(readModel).Return(projectTimeouts{})
 				handler.EXPECT().GetID(createdModel).Return(createdModel.Id.ValueString()).AnyTimes()
 
 				return testData{
@@ -234,12 +237,13 @@ func TestCreate(t *testing.T) {
 
 				api := NewMockapi[resource_elasticsearch_project.ElasticsearchProjectModel](ctrl)
 				api.EXPECT().Ready().Return(true)
-				api.EXPECT().Create(ctx, readModel).Return(createdModel, nil)
-				api.EXPECT().EnsureInitialised(ctx, createdModel).Return(nil)
-				api.EXPECT().Read(ctx, createdModel.Id.ValueString(), createdModel).Return(false, createdModel, nil)
+				api.EXPECT().Create(withDeadline(), readModel).Return(createdModel, nil)
+				api.EXPECT().EnsureInitialised(withDeadline(), createdModel).Return(nil)
+				api.EXPECT().Read(withDeadline(), createdModel.Id.ValueString(), createdModel).Return(false, createdModel, nil)
 
 				handler := NewMockmodelHandler[resource_elasticsearch_project.ElasticsearchProjectModel](ctrl)
 				handler.EXPECT().ReadFrom(ctx, req.Plan).Return(&readModel, nil)
+				handler.EXPECT().GetTimeouts(readModel).Return(projectTimeouts{})
 				handler.EXPECT().GetID(createdModel).Return(createdModel.Id.ValueString()).AnyTimes()
 
 				return testData{
@@ -278,12 +282,13 @@ func TestCreate(t *testing.T) {
 
 				api := NewMockapi[resource_elasticsearch_project.ElasticsearchProjectModel](ctrl)
 				api.EXPECT().Ready().Return(true)
-				api.EXPECT().Create(ctx, readModel).Return(createdModel, nil)
-				api.EXPECT().EnsureInitialised(ctx, createdModel).Return(nil)
-				api.EXPECT().Read(ctx, createdModel.Id.ValueString(), createdModel).Return(true, finalModel, nil)
+				api.EXPECT().Create(withDeadline(), readModel).Return(createdModel, nil)
+				api.EXPECT().EnsureInitialised(withDeadline(), createdModel).Return(nil)
+				api.EXPECT().Read(withDeadline(), createdModel.Id.ValueString(), createdModel).Return(true, finalModel, nil)
 
 				handler := NewMockmodelHandler[resource_elasticsearch_project.ElasticsearchProjectModel](ctrl)
 				handler.EXPECT().ReadFrom(ctx, req.Plan).Return(&readModel, nil)
+				handler.EXPECT().GetTimeouts(readModel).Return(projectTimeouts{})
 				handler.EXPECT().GetID(createdModel).Return(createdModel.Id.ValueString()).AnyTimes()
 
 				return testData{
@@ -34,10 +34,18 @@ func (r *Resource[T]) Delete(ctx context.Context, request resource.DeleteRequest
 		return
 	}
 
+	ctx, cancel := context.WithTimeout(ctx, r.modelHandler.GetTimeouts(*model).delete())
+	defer cancel()
+
 	response.Diagnostics.Append(r.api.Delete(ctx, *model)...)
 	if response.Diagnostics.HasError() {
 		return
 	}
 
+	response.Diagnostics.Append(r.api.EnsureDeleted(ctx, *model)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	response.State.RemoveResource(ctx)
 }
@@ -20,11 +20,13 @@ package projectresource
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/elastic/terraform-provider-ec/ec/internal/gen/serverless/resource_elasticsearch_project"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/stretchr/testify/require"
@@ -79,10 +81,11 @@ func TestDelete(t *testing.T) {
 
 		api := NewMockapi[resource_elasticsearch_project.ElasticsearchProjectModel](ctrl)
 		api.EXPECT().Ready().Return(true)
-		api.EXPECT().Delete(ctx, model).Return(deleteDiags)
+		api.EXPECT().Delete(withDeadline(), model).Return(deleteDiags)
 
 		handler := NewMockmodelHandler[resource_elasticsearch_project.ElasticsearchProjectModel](ctrl)
 		handler.EXPECT().ReadFrom(ctx, req.State).Return(&model, nil)
+		handler.EXPECT().GetTimeouts(model).Return(projectTimeouts{})
 
 		r := Resource[resource_elasticsearch_project.ElasticsearchProjectModel]{
 			api:          api,
@@ -94,6 +97,45 @@ func TestDelete(t *testing.T) {
 
 		require.Equal(t, deleteDiags, res.Diagnostics)
 	})
+	t.Run("should fail if the project is not deleted within the delete timeout", func(t *testing.T) {
+		ctx := context.Background()
+		req := resource.DeleteRequest{
+			State: tfsdk.State{
+				Raw: tftypes.NewValue(tftypes.Bool, true),
+			},
+		}
+
+		waitDiags := diag.Diagnostics{
+			diag.NewErrorDiagnostic("Timed out waiting for project to be deleted", "nope"),
+		}
+
+		model := resource_elasticsearch_project.ElasticsearchProjectModel{
+			Id: basetypes.NewStringValue("id"),
+		}
+
+		api := NewMockapi[resource_elasticsearch_project.ElasticsearchProjectModel](ctrl)
+		api.EXPECT().Ready().Return(true)
+		api.EXPECT().Delete(withDeadline(), model).Return(nil)
+		api.EXPECT().EnsureDeleted(withDeadline(), model).DoAndReturn(func(ctx context.Context, _ resource_elasticsearch_project.ElasticsearchProjectModel) diag.Diagnostics {
+			deadline, _ := ctx.Deadline()
+			require.WithinDuration(t, time.Now().Add(5*time.Minute), deadline, time.Minute)
+			return waitDiags
+		})
+
+		handler := NewMockmodelHandler[resource_elasticsearch_project.ElasticsearchProjectModel](ctrl)
+		handler.EXPECT().ReadFrom(ctx, req.State).Return(&model, nil)
+		handler.EXPECT().GetTimeouts(model).Return(projectTimeouts{Delete: types.StringValue("5m")})
+
+		r := Resource[resource_elasticsearch_project.ElasticsearchProjectModel]{
+			api:          api,
+			modelHandler: handler,
+		}
+
+		res := resource.DeleteResponse{}
+		r.Delete(ctx, req, &res)
+
+		require.Equal(t, waitDiags, res.Diagnostics)
+	})
 	t.Run("should remove the deleted project from state", func(t *testing.T) {
 		ctx := context.Background()
 		req := resource.DeleteRequest{
@@ -108,10 +150,12 @@ func TestDelete(t *testing.T) {
 
 		api := NewMockapi[resource_elasticsearch_project.ElasticsearchProjectModel](ctrl)
 		api.EXPECT().Ready().Return(true)
-		api.EXPECT().Delete(ctx, model).Return(nil)
+		api.EXPECT().Delete(withDeadline(), model).Return(nil)
+		api.EXPECT().EnsureDeleted(withDeadline(), model).Return(nil)
 
 		handler := NewMockmodelHandler[resource_elasticsearch_project.ElasticsearchProjectModel](ctrl)
 		handler.EXPECT().ReadFrom(ctx, req.State).Return(&model, nil)
+		handler.EXPECT().GetTimeouts(model).Return(projectTimeouts{})
 
 		r := Resource[resource_elasticsearch_project.ElasticsearchProjectModel]{
 			api:          api,
@@ -68,6 +68,16 @@ func (es elasticsearchModelReader) GetID(model resource_elasticsearch_project.El
 	return model.Id.ValueString()
 }
 
+func (es elasticsearchModelReader) GetTimeouts(model resource_elasticsearch_project.ElasticsearchProjectModel) projectTimeouts {
+	return projectTimeouts{
+		Create: model.Timeouts.Create,
+		Update: model.Timeouts.Update,
+		Delete: model.Timeouts.Delete,
+
+		PollInterval: model.Timeouts.PollInterval,
+	}
+}
+
 func (es elasticsearchModelReader) Modify(plan resource_elasticsearch_project.ElasticsearchProjectModel, state resource_elasticsearch_project.ElasticsearchProjectModel, cfg resource_elasticsearch_project.ElasticsearchProjectModel) resource_elasticsearch_project.ElasticsearchProjectModel {
 	plan.Credentials = useStateForUnknown(plan.Credentials, state.Credentials)
 	plan.Endpoints = useStateForUnknown(plan.Endpoints, state.Endpoints)
@@ -277,7 +287,7 @@ func (es elasticsearchApi) Patch(ctx context.Context, plan, state resource_elast
 }
 
 func (es elasticsearchApi) EnsureInitialised(ctx context.Context, model resource_elasticsearch_project.ElasticsearchProjectModel) diag.Diagnostics {
-	return waitForProjectInitialised(ctx, contextualSleep, func(ctx context.Context, id string) (serverless.ProjectStatusPhase, error) {
+	return waitForProjectInitialised(ctx, contextualSleep, elasticsearchModelReader{}.GetTimeouts(model).pollInterval(), func(ctx context.Context, id string) (serverless.ProjectStatusPhase, error) {
 		resp, err := es.client.GetElasticsearchProjectStatusWithResponse(ctx, id)
 		if err != nil {
 			return "", err
@@ -440,6 +450,23 @@ func (es elasticsearchApi) Delete(ctx context.Context, model resource_elasticsea
 	return nil
 }
 
+func (es elasticsearchApi) EnsureDeleted(ctx context.Context, model resource_elasticsearch_project.ElasticsearchProjectModel) diag.Diagnostics {
+	return waitForProjectDeleted(ctx, contextualSleep, elasticsearchModelReader{}.GetTimeouts(model).pollInterval(), func(ctx context.Context, id string) (serverless.ProjectStatusPhase, bool, error) {
+		resp, err := es.client.GetElasticsearchProjectStatusWithResponse(ctx, id)
+		if err != nil {
+			return "", false, err
+		}
+		if resp.StatusCode() == http.StatusNotFound {
+			return "", false, nil
+		}
+		if resp.JSON200 == nil {
+			return "", false, fmt.Errorf("failed to get elasticsearch_project status: %d %s\n%s",
+				resp.StatusCode(), resp.Status(), resp.Body)
+		}
+		return resp.JSON200.Phase, true, nil
+	}, model.Id.ValueString())
+}
+
 func flattenElasticsearchLinked(ctx context.Context, linked *serverless.LinkConfiguration) (resource_elasticsearch_project.LinkedValue, diag.Diagnostics) {
 	if linked == nil || len(linked.Projects) == 0 {
 		return resource_elasticsearch_project.NewLinkedValueNull(), nil
@@ -528,3 +555,33 @@ func expandLinkedForPatchElasticsearch(plan, state resource_elasticsearch_projec
 
 	return patch
 }
+
+func (es elasticsearchApi) ValidateLinkedProjects(ctx context.Context, plan, state resource_elasticsearch_project.ElasticsearchProjectModel) diag.Diagnostics {
+	if !util.IsKnown(plan.Id) || !util.IsKnown(plan.Linked) || plan.Linked.IsNull() {
+		return nil
+	}
+
+	var stateProjects basetypes.MapValue
+	if util.IsKnown(state.Linked) && !state.Linked.IsNull() {
+		stateProjects = state.Linked.Projects
+	}
+
+	projects := linkedProjectsToValidate(plan.Linked.Projects, stateProjects, func(v attr.Value) types.String {
+		pv, ok := v.(resource_elasticsearch_project.ProjectsValue)
+		if !ok {
+			return types.StringUnknown()
+		}
+		return pv.ProjectsType
+	})
+
+	return validateLinkedProjects(ctx, projects, func(ctx context.Context) ([]serverless.LinkedCandidateProject, error) {
+		resp, err := es.client.GetElasticsearchProjectLinkCandidatesWithResponse(ctx, plan.Id.ValueString(), nil)
+		if err != nil {
+			return nil, err
+		}
+		if resp.JSON200 == nil {
+			return nil, linkCandidatesError("elasticsearch", resp.StatusCode(), resp.Status(), resp.Body)
+		}
+		return resp.JSON200.Items, nil
+	})
+}
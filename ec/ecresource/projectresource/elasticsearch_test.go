@@ -31,6 +31,7 @@ import (
 	"github.com/elastic/terraform-provider-ec/ec/internal/util"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -111,6 +112,31 @@ func TestElasticsearchModelReader_GetID(t *testing.T) {
 	require.Equal(t, expectedId, mr.GetID(model))
 }
 
+func TestElasticsearchModelReader_GetTimeouts(t *testing.T) {
+	mr := elasticsearchModelReader{}
+	model := resource_elasticsearch_project.ElasticsearchProjectModel{
+		Timeouts: resource_elasticsearch_project.NewTimeoutsValueMust(
+			resource_elasticsearch_project.TimeoutsValue{}.AttributeTypes(context.Background()),
+			map[string]attr.Value{
+				"create": types.StringValue("1h"),
+				"update": types.StringNull(),
+				"delete": types.StringValue("10m"),
+
+				"poll_interval": types.StringValue("10s"),
+			},
+		),
+	}
+
+	require.Equal(t, projectTimeouts{
+		Create: types.StringValue("1h"),
+		Update: types.StringNull(),
+		Delete: types.StringValue("10m"),
+
+		PollInterval: types.StringValue("10s"),
+	}, mr.GetTimeouts(model))
+	require.Equal(t, projectTimeouts{}, mr.GetTimeouts(resource_elasticsearch_project.ElasticsearchProjectModel{}))
+}
+
 func TestElasticsearchModelReader_Modify(t *testing.T) {
 	type testData struct {
 		state    resource_elasticsearch_project.ElasticsearchProjectModel
@@ -952,6 +978,69 @@ func TestElasticsearchApi_EnsureInitialised(t *testing.T) {
 	}
 }
 
+func TestElasticsearchApi_EnsureDeleted(t *testing.T) {
+	model := resource_elasticsearch_project.ElasticsearchProjectModel{
+		Id: types.StringValue("project id"),
+	}
+	deleting := &serverless.GetElasticsearchProjectStatusResponse{
+		HTTPResponse: &http.Response{StatusCode: 200},
+		JSON200:      &serverless.ProjectStatus{Phase: serverless.ProjectStatusPhaseDeleting},
+	}
+
+	tests := []struct {
+		name          string
+		final         *serverless.GetElasticsearchProjectStatusResponse
+		expectedDiags diag.Diagnostics
+	}{
+		{
+			name: "should return once the project is not found",
+			final: &serverless.GetElasticsearchProjectStatusResponse{
+				HTTPResponse: &http.Response{StatusCode: 404},
+			},
+		},
+		{
+			name: "should return once the project is in the deleted phase",
+			final: &serverless.GetElasticsearchProjectStatusResponse{
+				HTTPResponse: &http.Response{StatusCode: 200},
+				JSON200:      &serverless.ProjectStatus{Phase: projectStatusPhaseDeleted},
+			},
+		},
+		{
+			name: "should error if the status check fails",
+			final: &serverless.GetElasticsearchProjectStatusResponse{
+				HTTPResponse: &http.Response{StatusCode: 500, Status: "failed"},
+				Body:         []byte("api call failed"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"failed to get elasticsearch_project status: 500 failed\napi call failed",
+					"failed to get elasticsearch_project status: 500 failed\napi call failed",
+				),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			origSleep := contextualSleep
+			contextualSleep = func(context.Context, time.Duration) {}
+			t.Cleanup(func() { contextualSleep = origSleep })
+
+			ctx := context.Background()
+			mockApiClient := mocks.NewMockClientWithResponsesInterface(gomock.NewController(t))
+			gomock.InOrder(
+				mockApiClient.EXPECT().GetElasticsearchProjectStatusWithResponse(gomock.Any(), model.Id.ValueString()).Return(deleting, nil).Times(2),
+				mockApiClient.EXPECT().GetElasticsearchProjectStatusWithResponse(gomock.Any(), model.Id.ValueString()).Return(tt.final, nil),
+			)
+
+			api := elasticsearchApi{sleeper: fakeSleeper{}}.WithClient(mockApiClient)
+
+			diags := api.EnsureDeleted(ctx, model)
+			require.Equal(t, tt.expectedDiags, diags)
+		})
+	}
+}
+
 func TestElasticsearchApi_Read(t *testing.T) {
 	ctrl := gomock.NewController(t)
 
@@ -1443,3 +1532,140 @@ func TestElasticsearchApi_Delete(t *testing.T) {
 		})
 	}
 }
+
+func TestElasticsearchApi_ValidateLinkedProjects(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	linkedModel := func(ctx context.Context, projects map[string]string) resource_elasticsearch_project.ElasticsearchProjectModel {
+		elems := make(map[string]attr.Value, len(projects))
+		for id, projectType := range projects {
+			elems[id], _ = resource_elasticsearch_project.NewProjectsValue(
+				resource_elasticsearch_project.ProjectsValue{}.AttributeTypes(ctx),
+				map[string]attr.Value{"type": types.StringValue(projectType)},
+			)
+		}
+		projectsMap, _ := types.MapValue(resource_elasticsearch_project.ProjectsValue{}.Type(ctx), elems)
+		linked, _ := resource_elasticsearch_project.NewLinkedValue(
+			resource_elasticsearch_project.LinkedValue{}.AttributeTypes(ctx),
+			map[string]attr.Value{
+				"projects": projectsMap,
+				"statuses": types.MapUnknown(types.StringType),
+			},
+		)
+		return resource_elasticsearch_project.ElasticsearchProjectModel{
+			Id:     types.StringValue("project id"),
+			Linked: linked,
+		}
+	}
+
+	type testData struct {
+		client        serverless.ClientWithResponsesInterface
+		plan          resource_elasticsearch_project.ElasticsearchProjectModel
+		state         resource_elasticsearch_project.ElasticsearchProjectModel
+		expectedDiags diag.Diagnostics
+	}
+	tests := []struct {
+		name     string
+		testData func(ctx context.Context) testData
+	}{
+		{
+			name: "should not query the API when linked projects are unchanged",
+			testData: func(ctx context.Context) testData {
+				model := linkedModel(ctx, map[string]string{"other": "elasticsearch"})
+				return testData{
+					client: mocks.NewMockClientWithResponsesInterface(ctrl),
+					plan:   model,
+					state:  model,
+				}
+			},
+		},
+		{
+			name: "should not query the API when linked is not configured",
+			testData: func(ctx context.Context) testData {
+				model := resource_elasticsearch_project.ElasticsearchProjectModel{
+					Id:     types.StringValue("project id"),
+					Linked: resource_elasticsearch_project.NewLinkedValueNull(),
+				}
+				return testData{
+					client: mocks.NewMockClientWithResponsesInterface(ctrl),
+					plan:   model,
+					state:  model,
+				}
+			},
+		},
+		{
+			name: "should error on new links which are not candidates",
+			testData: func(ctx context.Context) testData {
+				plan := linkedModel(ctx, map[string]string{"other": "elasticsearch", "missing": "security"})
+
+				mockApiClient := mocks.NewMockClientWithResponsesInterface(ctrl)
+				mockApiClient.EXPECT().
+					GetElasticsearchProjectLinkCandidatesWithResponse(ctx, "project id", nil).
+					Return(&serverless.GetElasticsearchProjectLinkCandidatesResponse{
+						HTTPResponse: &http.Response{StatusCode: 200},
+						JSON200: &serverless.LinkedCandidatesList{
+							Items: []serverless.LinkedCandidateProject{
+								{Id: "other", Type: "elasticsearch"},
+							},
+						},
+					}, nil)
+
+				return testData{
+					client: mockApiClient,
+					plan:   plan,
+					state: resource_elasticsearch_project.ElasticsearchProjectModel{
+						Linked: resource_elasticsearch_project.NewLinkedValueNull(),
+					},
+					expectedDiags: diag.Diagnostics{
+						diag.NewAttributeErrorDiagnostic(
+							path.Root("linked").AtName("projects").AtMapKey("missing"),
+							"Invalid linked project",
+							"Project missing cannot be linked to this project. Check that it exists, is in a compatible region and is of a type that can be linked. "+
+								"The ec_project_link_candidates data source lists all projects which can be linked.",
+						),
+					},
+				}
+			},
+		},
+		{
+			name: "should warn if the candidates can't be retrieved",
+			testData: func(ctx context.Context) testData {
+				plan := linkedModel(ctx, map[string]string{"other": "elasticsearch"})
+
+				mockApiClient := mocks.NewMockClientWithResponsesInterface(ctrl)
+				mockApiClient.EXPECT().
+					GetElasticsearchProjectLinkCandidatesWithResponse(ctx, "project id", nil).
+					Return(&serverless.GetElasticsearchProjectLinkCandidatesResponse{
+						HTTPResponse: &http.Response{StatusCode: 404, Status: "not found"},
+						Body:         []byte("nope"),
+					}, nil)
+
+				return testData{
+					client: mockApiClient,
+					plan:   plan,
+					state: resource_elasticsearch_project.ElasticsearchProjectModel{
+						Linked: resource_elasticsearch_project.NewLinkedValueNull(),
+					},
+					expectedDiags: diag.Diagnostics{
+						diag.NewAttributeWarningDiagnostic(
+							path.Root("linked").AtName("projects"),
+							"Unable to validate linked projects",
+							"Failed to list the link candidates for this project, linked projects will only be validated on apply: failed to get elasticsearch_project link candidates: 404 not found\nnope",
+						),
+					},
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			td := tt.testData(ctx)
+			api := elasticsearchApi{sleeper: fakeSleeper{}}.WithClient(td.client)
+
+			diags := api.ValidateLinkedProjects(ctx, td.plan, td.state)
+			require.Equal(t, td.expectedDiags, diags)
+		})
+	}
+}
@@ -1,9 +1,16 @@
 package projectresource
 
 import (
+	"context"
+	"fmt"
+	"slices"
+
 	"github.com/elastic/terraform-provider-ec/ec/internal/gen/serverless"
 	"github.com/elastic/terraform-provider-ec/ec/internal/util"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
 
@@ -48,3 +55,110 @@ func expandLinkedProjectsForPatch(
 
 	return &serverless.OptionalLinkConfiguration{Projects: &projects}
 }
+
+// linkedProjectsToValidate returns the linked projects, keyed by project ID and
+// mapped to their configured type, which were added to the plan or whose type
+// changed since the prior state. Established links are skipped so a plan that
+// doesn't touch linked.projects never has to query the API, and entries whose
+// type isn't known yet are skipped since they can't be checked.
+func linkedProjectsToValidate(
+	planProjects, stateProjects basetypes.MapValue,
+	typeOf func(attr.Value) types.String,
+) map[string]string {
+	if !util.IsKnown(planProjects) || planProjects.IsNull() {
+		return nil
+	}
+
+	stateElems := map[string]attr.Value{}
+	if util.IsKnown(stateProjects) && !stateProjects.IsNull() {
+		stateElems = stateProjects.Elements()
+	}
+
+	projects := map[string]string{}
+	for id, v := range planProjects.Elements() {
+		projectType := typeOf(v)
+		if !util.IsKnown(projectType) || projectType.IsNull() {
+			continue
+		}
+
+		if stateValue, ok := stateElems[id]; ok && typeOf(stateValue).Equal(projectType) {
+			continue
+		}
+
+		projects[id] = projectType.ValueString()
+	}
+
+	return projects
+}
+
+// validateLinkedProjects checks every entry in projects against the link
+// candidates returned by listCandidates. Projects which are not a candidate
+// (wrong region, incompatible type, deleted...) or whose configured type
+// doesn't match the candidate's type produce an error on the specific
+// linked.projects map key.
+//
+// Failing to list the candidates only results in a warning, the apply will
+// still surface any invalid link.
+func validateLinkedProjects(
+	ctx context.Context,
+	projects map[string]string,
+	listCandidates func(context.Context) ([]serverless.LinkedCandidateProject, error),
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if len(projects) == 0 {
+		return diags
+	}
+
+	items, err := listCandidates(ctx)
+	if err != nil {
+		diags.AddAttributeWarning(
+			path.Root("linked").AtName("projects"),
+			"Unable to validate linked projects",
+			fmt.Sprintf("Failed to list the link candidates for this project, linked projects will only be validated on apply: %s", err),
+		)
+		return diags
+	}
+
+	candidates := make(map[string]serverless.LinkedCandidateProject, len(items))
+	for _, candidate := range items {
+		candidates[candidate.Id] = candidate
+	}
+
+	ids := make([]string, 0, len(projects))
+	for id := range projects {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+
+	for _, id := range ids {
+		projectType := projects[id]
+		projectPath := path.Root("linked").AtName("projects").AtMapKey(id)
+
+		candidate, ok := candidates[id]
+		if !ok {
+			diags.AddAttributeError(
+				projectPath,
+				"Invalid linked project",
+				fmt.Sprintf("Project %s cannot be linked to this project. Check that it exists, is in a compatible region and is of a type that can be linked. "+
+					"The ec_project_link_candidates data source lists all projects which can be linked.", id),
+			)
+			continue
+		}
+
+		if string(candidate.Type) != projectType {
+			diags.AddAttributeError(
+				projectPath.AtName("type"),
+				"Invalid linked project type",
+				fmt.Sprintf("Project %s is a %s project, but is configured with type %q.", id, candidate.Type, projectType),
+			)
+		}
+	}
+
+	return diags
+}
+
+// linkCandidatesError builds the error returned when listing the link
+// candidates of a project doesn't succeed.
+func linkCandidatesError(projectType string, statusCode int, status string, body []byte) error {
+	return fmt.Errorf("failed to get %s_project link candidates: %d %s\n%s", projectType, statusCode, status, body)
+}
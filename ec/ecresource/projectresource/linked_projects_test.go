@@ -1,10 +1,14 @@
 package projectresource
 
 import (
+	"context"
+	"errors"
 	"testing"
 
 	"github.com/elastic/terraform-provider-ec/ec/internal/gen/serverless"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/stretchr/testify/require"
@@ -113,3 +117,146 @@ func TestExpandLinkedProjectsForPatch(t *testing.T) {
 		})
 	}
 }
+
+func TestLinkedProjectsToValidate(t *testing.T) {
+	typeOf := func(v attr.Value) types.String {
+		return v.(basetypes.StringValue)
+	}
+
+	mapStr := func(m map[string]attr.Value) basetypes.MapValue {
+		if m == nil {
+			return types.MapNull(types.StringType)
+		}
+		out, _ := types.MapValue(types.StringType, m)
+		return out
+	}
+
+	tests := []struct {
+		name  string
+		plan  basetypes.MapValue
+		state basetypes.MapValue
+		want  map[string]string
+	}{
+		{
+			name:  "returns nothing when the plan is null",
+			plan:  mapStr(nil),
+			state: mapStr(map[string]attr.Value{"a": types.StringValue("elasticsearch")}),
+		},
+		{
+			name:  "returns nothing when the plan is unknown",
+			plan:  types.MapUnknown(types.StringType),
+			state: mapStr(nil),
+		},
+		{
+			name:  "returns all keys when there is no prior state",
+			plan:  mapStr(map[string]attr.Value{"a": types.StringValue("elasticsearch"), "b": types.StringValue("security")}),
+			state: mapStr(nil),
+			want:  map[string]string{"a": "elasticsearch", "b": "security"},
+		},
+		{
+			name:  "skips links which are already in state",
+			plan:  mapStr(map[string]attr.Value{"a": types.StringValue("elasticsearch"), "b": types.StringValue("security")}),
+			state: mapStr(map[string]attr.Value{"a": types.StringValue("elasticsearch")}),
+			want:  map[string]string{"b": "security"},
+		},
+		{
+			name:  "returns links whose type changed",
+			plan:  mapStr(map[string]attr.Value{"a": types.StringValue("observability")}),
+			state: mapStr(map[string]attr.Value{"a": types.StringValue("elasticsearch")}),
+			want:  map[string]string{"a": "observability"},
+		},
+		{
+			name:  "skips links with an unknown type",
+			plan:  mapStr(map[string]attr.Value{"a": types.StringUnknown(), "b": types.StringValue("security")}),
+			state: mapStr(nil),
+			want:  map[string]string{"b": "security"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := linkedProjectsToValidate(tt.plan, tt.state, typeOf)
+			if len(tt.want) == 0 {
+				require.Empty(t, got)
+				return
+			}
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestValidateLinkedProjects(t *testing.T) {
+	candidates := []serverless.LinkedCandidateProject{
+		{Id: "es-project", Type: serverless.ProjectType("elasticsearch")},
+		{Id: "obs-project", Type: serverless.ProjectType("observability"), Linked: true},
+	}
+	projectsPath := path.Root("linked").AtName("projects")
+
+	tests := []struct {
+		name          string
+		projects      map[string]string
+		listErr       error
+		expectList    bool
+		expectedDiags diag.Diagnostics
+	}{
+		{
+			name: "doesn't list candidates when there is nothing to validate",
+		},
+		{
+			name:       "accepts projects which are link candidates",
+			projects:   map[string]string{"es-project": "elasticsearch", "obs-project": "observability"},
+			expectList: true,
+		},
+		{
+			name:       "reports projects which are not link candidates",
+			projects:   map[string]string{"es-project": "elasticsearch", "unknown-project": "security"},
+			expectList: true,
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					projectsPath.AtMapKey("unknown-project"),
+					"Invalid linked project",
+					"Project unknown-project cannot be linked to this project. Check that it exists, is in a compatible region and is of a type that can be linked. "+
+						"The ec_project_link_candidates data source lists all projects which can be linked.",
+				),
+			},
+		},
+		{
+			name:       "reports projects configured with the wrong type",
+			projects:   map[string]string{"es-project": "security"},
+			expectList: true,
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					projectsPath.AtMapKey("es-project").AtName("type"),
+					"Invalid linked project type",
+					`Project es-project is a elasticsearch project, but is configured with type "security".`,
+				),
+			},
+		},
+		{
+			name:       "warns when the candidates can't be listed",
+			projects:   map[string]string{"es-project": "elasticsearch"},
+			listErr:    errors.New("boom"),
+			expectList: true,
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeWarningDiagnostic(
+					projectsPath,
+					"Unable to validate linked projects",
+					"Failed to list the link candidates for this project, linked projects will only be validated on apply: boom",
+				),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			listed := false
+			diags := validateLinkedProjects(context.Background(), tt.projects, func(context.Context) ([]serverless.LinkedCandidateProject, error) {
+				listed = true
+				return candidates, tt.listErr
+			})
+
+			require.Equal(t, tt.expectList, listed)
+			require.Equal(t, tt.expectedDiags, diags)
+		})
+	}
+}
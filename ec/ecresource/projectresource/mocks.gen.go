@@ -110,6 +110,20 @@ func (mr *MockmodelHandlerMockRecorder[T]) GetID(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetID", reflect.TypeOf((*MockmodelHandler[T])(nil).GetID), arg0)
 }
 
+// GetTimeouts mocks base method.
+func (m *MockmodelHandler[T]) GetTimeouts(arg0 T) projectTimeouts {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTimeouts", arg0)
+	ret0, _ := ret[0].(projectTimeouts)
+	return ret0
+}
+
+// GetTimeouts indicates an expected call of GetTimeouts.
+func (mr *MockmodelHandlerMockRecorder[T]) GetTimeouts(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTimeouts", reflect.TypeOf((*MockmodelHandler[T])(nil).GetTimeouts), arg0)
+}
+
 // Modify mocks base method.
 func (m *MockmodelHandler[T]) Modify(arg0, arg1, arg2 T) T {
 	m.ctrl.T.Helper()
@@ -203,6 +217,20 @@ func (mr *MockapiMockRecorder[TModel]) Delete(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*Mockapi[TModel])(nil).Delete), arg0, arg1)
 }
 
+// EnsureDeleted mocks base method.
+func (m *Mockapi[TModel]) EnsureDeleted(arg0 context.Context, arg1 TModel) diag.Diagnostics {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnsureDeleted", arg0, arg1)
+	ret0, _ := ret[0].(diag.Diagnostics)
+	return ret0
+}
+
+// EnsureDeleted indicates an expected call of EnsureDeleted.
+func (mr *MockapiMockRecorder[TModel]) EnsureDeleted(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnsureDeleted", reflect.TypeOf((*Mockapi[TModel])(nil).EnsureDeleted), arg0, arg1)
+}
+
 // EnsureInitialised mocks base method.
 func (m *Mockapi[TModel]) EnsureInitialised(arg0 context.Context, arg1 TModel) diag.Diagnostics {
 	m.ctrl.T.Helper()
@@ -261,6 +289,20 @@ func (mr *MockapiMockRecorder[TModel]) Ready() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ready", reflect.TypeOf((*Mockapi[TModel])(nil).Ready))
 }
 
+// ValidateLinkedProjects mocks base method.
+func (m *Mockapi[TModel]) ValidateLinkedProjects(arg0 context.Context, arg1, arg2 TModel) diag.Diagnostics {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateLinkedProjects", arg0, arg1, arg2)
+	ret0, _ := ret[0].(diag.Diagnostics)
+	return ret0
+}
+
+// ValidateLinkedProjects indicates an expected call of ValidateLinkedProjects.
+func (mr *MockapiMockRecorder[TModel]) ValidateLinkedProjects(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateLinkedProjects", reflect.TypeOf((*Mockapi[TModel])(nil).ValidateLinkedProjects), arg0, arg1, arg2)
+}
+
 // WithClient mocks base method.
 func (m *Mockapi[TModel]) WithClient(arg0 serverless.ClientWithResponsesInterface) api[TModel] {
 	m.ctrl.T.Helper()
@@ -55,6 +55,16 @@ func (obs observabilityModelReader) GetID(model resource_observability_project.O
 	return model.Id.ValueString()
 }
 
+func (obs observabilityModelReader) GetTimeouts(model resource_observability_project.ObservabilityProjectModel) projectTimeouts {
+	return projectTimeouts{
+		Create: model.Timeouts.Create,
+		Update: model.Timeouts.Update,
+		Delete: model.Timeouts.Delete,
+
+		PollInterval: model.Timeouts.PollInterval,
+	}
+}
+
 func (obs observabilityModelReader) Modify(plan resource_observability_project.ObservabilityProjectModel, state resource_observability_project.ObservabilityProjectModel, cfg resource_observability_project.ObservabilityProjectModel) resource_observability_project.ObservabilityProjectModel {
 	plan.Credentials = useStateForUnknown(plan.Credentials, state.Credentials)
 	plan.Endpoints = useStateForUnknown(plan.Endpoints, state.Endpoints)
@@ -240,7 +250,7 @@ func (obs observabilityApi) Patch(ctx context.Context, plan, state resource_obse
 }
 
 func (obs observabilityApi) EnsureInitialised(ctx context.Context, model resource_observability_project.ObservabilityProjectModel) diag.Diagnostics {
-	return waitForProjectInitialised(ctx, contextualSleep, func(ctx context.Context, id string) (serverless.ProjectStatusPhase, error) {
+	return waitForProjectInitialised(ctx, contextualSleep, observabilityModelReader{}.GetTimeouts(model).pollInterval(), func(ctx context.Context, id string) (serverless.ProjectStatusPhase, error) {
 		resp, err := obs.client.GetObservabilityProjectStatusWithResponse(ctx, id)
 		if err != nil {
 			return "", err
@@ -391,6 +401,23 @@ func (obs observabilityApi) Delete(ctx context.Context, model resource_observabi
 	return nil
 }
 
+func (obs observabilityApi) EnsureDeleted(ctx context.Context, model resource_observability_project.ObservabilityProjectModel) diag.Diagnostics {
+	return waitForProjectDeleted(ctx, contextualSleep, observabilityModelReader{}.GetTimeouts(model).pollInterval(), func(ctx context.Context, id string) (serverless.ProjectStatusPhase, bool, error) {
+		resp, err := obs.client.GetObservabilityProjectStatusWithResponse(ctx, id)
+		if err != nil {
+			return "", false, err
+		}
+		if resp.StatusCode() == http.StatusNotFound {
+			return "", false, nil
+		}
+		if resp.JSON200 == nil {
+			return "", false, fmt.Errorf("failed to get observability_project status: %d %s\n%s",
+				resp.StatusCode(), resp.Status(), resp.Body)
+		}
+		return resp.JSON200.Phase, true, nil
+	}, model.Id.ValueString())
+}
+
 func flattenObservabilityLinked(ctx context.Context, linked *serverless.LinkConfiguration) (resource_observability_project.LinkedValue, diag.Diagnostics) {
 	if linked == nil || len(linked.Projects) == 0 {
 		return resource_observability_project.NewLinkedValueNull(), nil
@@ -477,3 +504,33 @@ func expandLinkedForPatchObservability(plan, state resource_observability_projec
 		}
 	})
 }
+
+func (obs observabilityApi) ValidateLinkedProjects(ctx context.Context, plan, state resource_observability_project.ObservabilityProjectModel) diag.Diagnostics {
+	if !util.IsKnown(plan.Id) || !util.IsKnown(plan.Linked) || plan.Linked.IsNull() {
+		return nil
+	}
+
+	var stateProjects basetypes.MapValue
+	if util.IsKnown(state.Linked) && !state.Linked.IsNull() {
+		stateProjects = state.Linked.Projects
+	}
+
+	projects := linkedProjectsToValidate(plan.Linked.Projects, stateProjects, func(v attr.Value) types.String {
+		pv, ok := v.(resource_observability_project.ProjectsValue)
+		if !ok {
+			return types.StringUnknown()
+		}
+		return pv.ProjectsType
+	})
+
+	return validateLinkedProjects(ctx, projects, func(ctx context.Context) ([]serverless.LinkedCandidateProject, error) {
+		resp, err := obs.client.GetObservabilityProjectLinkCandidatesWithResponse(ctx, plan.Id.ValueString(), nil)
+		if err != nil {
+			return nil, err
+		}
+		if resp.JSON200 == nil {
+			return nil, linkCandidatesError("observability", resp.StatusCode(), resp.Status(), resp.Body)
+		}
+		return resp.JSON200.Items, nil
+	})
+}
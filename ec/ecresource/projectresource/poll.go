@@ -26,56 +26,86 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 )
 
-const (
-	// projectInitPollInterval is the interval between project status polls
-	// while waiting for a newly created project to become initialised. Project
-	// initialisation takes on the order of seconds to minutes, so a 5s interval
-	// avoids burning Serverless API rate-limit quota unnecessarily.
-	projectInitPollInterval = 5 * time.Second
-)
-
-// projectInitPollTimeout bounds the total time spent waiting for a project to
-// initialise. It is a var so tests can shorten it. Without a bound a stuck
-// project would be polled forever.
-var projectInitPollTimeout = 30 * time.Minute
+// projectStatusPhaseDeleted is the terminal phase of a deleted project. It is
+// documented by the API, but missing from the generated ProjectStatusPhase enum.
+const projectStatusPhaseDeleted serverless.ProjectStatusPhase = "deleted"
 
 // waitForProjectInitialised polls the project status endpoint until the project
-// is initialised or projectInitPollTimeout elapses. 429 responses from the
+// is initialised or ctx is done. The caller bounds the wait by giving ctx a
+// deadline, usually the create timeout of the resource. 429 responses from the
 // Serverless API are retried at the HTTP layer; any other error from getStatus
 // is returned immediately.
 //
 // wait is used to pause between polls and is injected so tests can avoid real
 // sleeps. It should return early when ctx is cancelled; the default
-// implementation (contextualSleep) does so.
+// implementation (contextualSleep) does so. interval is the pause between
+// polls, usually the poll interval of the resource timeouts.
 func waitForProjectInitialised(
 	ctx context.Context,
 	wait func(ctx context.Context, d time.Duration),
+	interval time.Duration,
 	getStatus func(ctx context.Context, id string) (serverless.ProjectStatusPhase, error),
 	id string,
 ) diag.Diagnostics {
-	ctx, cancel := context.WithTimeout(ctx, projectInitPollTimeout)
-	defer cancel()
+	return pollProjectStatus(ctx, wait, interval, func(ctx context.Context) (bool, error) {
+		phase, err := getStatus(ctx, id)
+		return phase == serverless.ProjectStatusPhaseInitialized, err
+	}, diag.NewErrorDiagnostic(
+		"Timed out waiting for project to initialise",
+		fmt.Sprintf("Project %s did not reach the initialised phase within the create timeout.", id),
+	))
+}
+
+// waitForProjectDeleted polls the project status endpoint until the project is
+// gone or ctx is done. getStatus reports found=false once the API no longer
+// knows the project. A project in the deleted phase is considered gone as well.
+//
+// Waiting for the project to disappear lets resources it depends on, such as
+// serverless traffic filters, be deleted in the same apply.
+func waitForProjectDeleted(
+	ctx context.Context,
+	wait func(ctx context.Context, d time.Duration),
+	interval time.Duration,
+	getStatus func(ctx context.Context, id string) (phase serverless.ProjectStatusPhase, found bool, err error),
+	id string,
+) diag.Diagnostics {
+	return pollProjectStatus(ctx, wait, interval, func(ctx context.Context) (bool, error) {
+		phase, found, err := getStatus(ctx, id)
+		return !found || phase == projectStatusPhaseDeleted, err
+	}, diag.NewErrorDiagnostic(
+		"Timed out waiting for project to be deleted",
+		fmt.Sprintf("Project %s was still present at the end of the delete timeout.", id),
+	))
+}
 
+// pollProjectStatus calls done every interval until it returns true or an
+// error. timedOut is returned when ctx is done before that.
+func pollProjectStatus(
+	ctx context.Context,
+	wait func(ctx context.Context, d time.Duration),
+	interval time.Duration,
+	done func(ctx context.Context) (bool, error),
+	timedOut diag.Diagnostic,
+) diag.Diagnostics {
 	for {
-		phase, err := getStatus(ctx, id)
+		finished, err := done(ctx)
 		if err != nil {
+			// A request aborted by the deadline is a timeout rather than an API failure.
+			if ctx.Err() != nil {
+				return diag.Diagnostics{timedOut}
+			}
 			return diag.Diagnostics{
 				diag.NewErrorDiagnostic(err.Error(), err.Error()),
 			}
 		}
-		if phase == serverless.ProjectStatusPhaseInitialized {
+		if finished {
 			return nil
 		}
 
 		if ctx.Err() != nil {
-			return diag.Diagnostics{
-				diag.NewErrorDiagnostic(
-					"Timed out waiting for project to initialise",
-					fmt.Sprintf("Project %s did not reach the initialised phase within %s.", id, projectInitPollTimeout),
-				),
-			}
+			return diag.Diagnostics{timedOut}
 		}
-		wait(ctx, projectInitPollInterval)
+		wait(ctx, interval)
 	}
 }
 
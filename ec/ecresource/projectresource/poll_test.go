@@ -48,11 +48,31 @@ func TestWaitForProjectInitialised_ReturnsWhenInitialised(t *testing.T) {
 		return serverless.ProjectStatusPhaseInitialized, nil
 	}
 
-	diags := waitForProjectInitialised(context.Background(), contextualSleep, getStatus, "id")
+	diags := waitForProjectInitialised(context.Background(), contextualSleep, defaultPollInterval, getStatus, "id")
 	require.False(t, diags.HasError())
 	require.Equal(t, 3, calls)
 }
 
+func TestWaitForProjectInitialised_WaitsForTheIntervalBetweenPolls(t *testing.T) {
+	var waits []time.Duration
+	wait := func(_ context.Context, d time.Duration) {
+		waits = append(waits, d)
+	}
+
+	calls := 0
+	getStatus := func(_ context.Context, _ string) (serverless.ProjectStatusPhase, error) {
+		calls++
+		if calls < 3 {
+			return serverless.ProjectStatusPhaseInitializing, nil
+		}
+		return serverless.ProjectStatusPhaseInitialized, nil
+	}
+
+	diags := waitForProjectInitialised(context.Background(), wait, 30*time.Second, getStatus, "id")
+	require.False(t, diags.HasError())
+	require.Equal(t, []time.Duration{30 * time.Second, 30 * time.Second}, waits)
+}
+
 func TestWaitForProjectInitialised_PropagatesGetStatusError(t *testing.T) {
 	withNoopSleep(t)
 
@@ -61,25 +81,93 @@ func TestWaitForProjectInitialised_PropagatesGetStatusError(t *testing.T) {
 		return "", wantErr
 	}
 
-	diags := waitForProjectInitialised(context.Background(), contextualSleep, getStatus, "id")
+	diags := waitForProjectInitialised(context.Background(), contextualSleep, defaultPollInterval, getStatus, "id")
 	require.True(t, diags.HasError())
 	require.Equal(t, "boom", diags[0].Summary())
 	require.Equal(t, "boom", diags[0].Detail())
 }
 
 func TestWaitForProjectInitialised_TimesOut(t *testing.T) {
-	// Override the timeout to something tiny so the test is fast.
-	origTimeout := projectInitPollTimeout
-	projectInitPollTimeout = 50 * time.Millisecond
-	t.Cleanup(func() { projectInitPollTimeout = origTimeout })
+	// Use a tiny deadline so the test is fast.
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	t.Cleanup(cancel)
 	withNoopSleep(t)
 
 	getStatus := func(_ context.Context, _ string) (serverless.ProjectStatusPhase, error) {
 		return serverless.ProjectStatusPhaseInitializing, nil
 	}
 
-	diags := waitForProjectInitialised(context.Background(), contextualSleep, getStatus, "id")
+	diags := waitForProjectInitialised(ctx, contextualSleep, defaultPollInterval, getStatus, "id")
 	require.True(t, diags.HasError())
 	require.Contains(t, diags[0].Summary(), "Timed out waiting for project to initialise")
 	require.Contains(t, diags[0].Detail(), "id")
 }
+
+func TestWaitForProjectInitialised_ReportsRequestsAbortedByTheDeadlineAsTimeout(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	withNoopSleep(t)
+
+	getStatus := func(ctx context.Context, _ string) (serverless.ProjectStatusPhase, error) {
+		return "", ctx.Err()
+	}
+
+	diags := waitForProjectInitialised(ctx, contextualSleep, defaultPollInterval, getStatus, "id")
+	require.True(t, diags.HasError())
+	require.Equal(t, "Timed out waiting for project to initialise", diags[0].Summary())
+}
+
+func TestWaitForProjectDeleted_ReturnsWhenNotFound(t *testing.T) {
+	withNoopSleep(t)
+
+	calls := 0
+	getStatus := func(_ context.Context, _ string) (serverless.ProjectStatusPhase, bool, error) {
+		calls++
+		if calls < 3 {
+			return serverless.ProjectStatusPhaseDeleting, true, nil
+		}
+		return "", false, nil
+	}
+
+	diags := waitForProjectDeleted(context.Background(), contextualSleep, defaultPollInterval, getStatus, "id")
+	require.False(t, diags.HasError())
+	require.Equal(t, 3, calls)
+}
+
+func TestWaitForProjectDeleted_ReturnsWhenDeleted(t *testing.T) {
+	withNoopSleep(t)
+
+	getStatus := func(_ context.Context, _ string) (serverless.ProjectStatusPhase, bool, error) {
+		return projectStatusPhaseDeleted, true, nil
+	}
+
+	diags := waitForProjectDeleted(context.Background(), contextualSleep, defaultPollInterval, getStatus, "id")
+	require.False(t, diags.HasError())
+}
+
+func TestWaitForProjectDeleted_PropagatesGetStatusError(t *testing.T) {
+	withNoopSleep(t)
+
+	getStatus := func(_ context.Context, _ string) (serverless.ProjectStatusPhase, bool, error) {
+		return "", false, errors.New("boom")
+	}
+
+	diags := waitForProjectDeleted(context.Background(), contextualSleep, defaultPollInterval, getStatus, "id")
+	require.True(t, diags.HasError())
+	require.Equal(t, "boom", diags[0].Summary())
+}
+
+func TestWaitForProjectDeleted_TimesOut(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	t.Cleanup(cancel)
+	withNoopSleep(t)
+
+	getStatus := func(_ context.Context, _ string) (serverless.ProjectStatusPhase, bool, error) {
+		return serverless.ProjectStatusPhaseDeleting, true, nil
+	}
+
+	diags := waitForProjectDeleted(ctx, contextualSleep, defaultPollInterval, getStatus, "id")
+	require.True(t, diags.HasError())
+	require.Equal(t, "Timed out waiting for project to be deleted", diags[0].Summary())
+	require.Contains(t, diags[0].Detail(), "id")
+}
@@ -53,6 +53,7 @@ type modelHandler[T any] interface {
 	Schema(context.Context, resource.SchemaRequest, *resource.SchemaResponse)
 	ReadFrom(context.Context, modelGetter) (*T, diag.Diagnostics)
 	GetID(T) string
+	GetTimeouts(T) projectTimeouts
 	Modify(T, T, T) T
 }
 
@@ -64,6 +65,12 @@ type api[TModel any] interface {
 	EnsureInitialised(context.Context, TModel) diag.Diagnostics
 	Read(context.Context, string, TModel) (bool, TModel, diag.Diagnostics)
 	Delete(context.Context, TModel) diag.Diagnostics
+	// EnsureDeleted waits until the project deleted by Delete is no longer returned by the API.
+	EnsureDeleted(context.Context, TModel) diag.Diagnostics
+	// ValidateLinkedProjects checks the linked projects added to the plan model against the
+	// link candidates of the project. The second model is prior state, links already present
+	// there aren't validated again.
+	ValidateLinkedProjects(context.Context, TModel, TModel) diag.Diagnostics
 	WithClient(serverless.ClientWithResponsesInterface) api[TModel]
 	Ready() bool
 }
@@ -126,6 +133,16 @@ func (r Resource[T]) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequ
 	}
 
 	modifiedModel := r.modelHandler.Modify(*planModel, *stateModel, *cfgModel)
+
+	// Link candidates are relative to an existing project, so linked projects can only be
+	// validated once the project has been created.
+	if r.api.Ready() {
+		resp.Diagnostics.Append(r.api.ValidateLinkedProjects(ctx, modifiedModel, *stateModel)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	resp.Diagnostics.Append(resp.Plan.Set(ctx, modifiedModel)...)
 }
 
@@ -26,6 +26,7 @@ import (
 	"github.com/elastic/terraform-provider-ec/ec/internal/gen/serverless/mocks"
 	"github.com/elastic/terraform-provider-ec/ec/internal/gen/serverless/resource_elasticsearch_project"
 	"github.com/elastic/terraform-provider-ec/ec/internal/util"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
@@ -175,8 +176,13 @@ func TestModifyPlan(t *testing.T) {
 		mockHandler.EXPECT().ReadFrom(ctx, req.Plan).Return(planModel, nil)
 		mockHandler.EXPECT().Modify(*planModel, *stateModel, *cfgModel).Return(*planModel)
 
+		mockApi := NewMockapi[resource_elasticsearch_project.ElasticsearchProjectModel](ctrl)
+		mockApi.EXPECT().Ready().Return(true)
+		mockApi.EXPECT().ValidateLinkedProjects(ctx, *planModel, *stateModel).Return(nil)
+
 		r := Resource[resource_elasticsearch_project.ElasticsearchProjectModel]{
 			modelHandler: mockHandler,
+			api:          mockApi,
 		}
 		r.ModifyPlan(ctx, req, &res)
 
@@ -185,6 +191,61 @@ func TestModifyPlan(t *testing.T) {
 		res.Plan.GetAttribute(ctx, path.Root("id"), &id)
 		require.Equal(t, planModel.Id.ValueString(), id)
 	})
+	t.Run("should not set the plan if the linked projects are invalid", func(t *testing.T) {
+		ctx := context.Background()
+		req := resource.ModifyPlanRequest{
+			Config: tfsdk.Config{
+				Raw: tftypes.NewValue(tftypes.String, "config"),
+			},
+			State: tfsdk.State{
+				Raw: tftypes.NewValue(tftypes.String, "state"),
+			},
+			Plan: tfsdk.Plan{
+				Raw: tftypes.NewValue(tftypes.String, "plan"),
+			},
+		}
+		res := resource.ModifyPlanResponse{
+			Plan: tfsdk.Plan{
+				Schema: resource_elasticsearch_project.ElasticsearchProjectResourceSchema(ctx),
+			},
+		}
+
+		planModel := &resource_elasticsearch_project.ElasticsearchProjectModel{
+			Id:               types.StringValue("plan"),
+			TrafficFilterIds: types.SetNull(types.StringType),
+		}
+		stateModel := &resource_elasticsearch_project.ElasticsearchProjectModel{
+			Id:               types.StringValue("state"),
+			TrafficFilterIds: types.SetNull(types.StringType),
+		}
+		cfgModel := &resource_elasticsearch_project.ElasticsearchProjectModel{
+			Id:               types.StringValue("config"),
+			TrafficFilterIds: types.SetNull(types.StringType),
+		}
+
+		expectedDiags := diag.Diagnostics{
+			diag.NewAttributeErrorDiagnostic(path.Root("linked").AtName("projects").AtMapKey("other"), "Invalid linked project", "nope"),
+		}
+
+		mockHandler := NewMockmodelHandler[resource_elasticsearch_project.ElasticsearchProjectModel](ctrl)
+		mockHandler.EXPECT().ReadFrom(ctx, req.Config).Return(cfgModel, nil)
+		mockHandler.EXPECT().ReadFrom(ctx, req.State).Return(stateModel, nil)
+		mockHandler.EXPECT().ReadFrom(ctx, req.Plan).Return(planModel, nil)
+		mockHandler.EXPECT().Modify(*planModel, *stateModel, *cfgModel).Return(*planModel)
+
+		mockApi := NewMockapi[resource_elasticsearch_project.ElasticsearchProjectModel](ctrl)
+		mockApi.EXPECT().Ready().Return(true)
+		mockApi.EXPECT().ValidateLinkedProjects(ctx, *planModel, *stateModel).Return(expectedDiags)
+
+		r := Resource[resource_elasticsearch_project.ElasticsearchProjectModel]{
+			modelHandler: mockHandler,
+			api:          mockApi,
+		}
+		r.ModifyPlan(ctx, req, &res)
+
+		require.Equal(t, expectedDiags, res.Diagnostics)
+		require.True(t, res.Plan.Raw.IsNull())
+	})
 }
 
 func TestImportState(t *testing.T) {
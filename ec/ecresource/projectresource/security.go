@@ -74,6 +74,16 @@ func (sec securityModelReader) GetID(model resource_security_project.SecurityPro
 	return model.Id.ValueString()
 }
 
+func (sec securityModelReader) GetTimeouts(model resource_security_project.SecurityProjectModel) projectTimeouts {
+	return projectTimeouts{
+		Create: model.Timeouts.Create,
+		Update: model.Timeouts.Update,
+		Delete: model.Timeouts.Delete,
+
+		PollInterval: model.Timeouts.PollInterval,
+	}
+}
+
 func (sec securityModelReader) Modify(plan resource_security_project.SecurityProjectModel, state resource_security_project.SecurityProjectModel, cfg resource_security_project.SecurityProjectModel) resource_security_project.SecurityProjectModel {
 	plan.Credentials = useStateForUnknown(plan.Credentials, state.Credentials)
 	plan.Endpoints = useStateForUnknown(plan.Endpoints, state.Endpoints)
@@ -261,7 +271,7 @@ func (sec securityApi) Patch(ctx context.Context, plan, state resource_security_
 }
 
 func (sec securityApi) EnsureInitialised(ctx context.Context, model resource_security_project.SecurityProjectModel) diag.Diagnostics {
-	return waitForProjectInitialised(ctx, contextualSleep, func(ctx context.Context, id string) (serverless.ProjectStatusPhase, error) {
+	return waitForProjectInitialised(ctx, contextualSleep, securityModelReader{}.GetTimeouts(model).pollInterval(), func(ctx context.Context, id string) (serverless.ProjectStatusPhase, error) {
 		resp, err := sec.client.GetSecurityProjectStatusWithResponse(ctx, id)
 		if err != nil {
 			return "", err
@@ -492,6 +502,23 @@ func (sec securityApi) Delete(ctx context.Context, model resource_security_proje
 	return nil
 }
 
+func (sec securityApi) EnsureDeleted(ctx context.Context, model resource_security_project.SecurityProjectModel) diag.Diagnostics {
+	return waitForProjectDeleted(ctx, contextualSleep, securityModelReader{}.GetTimeouts(model).pollInterval(), func(ctx context.Context, id string) (serverless.ProjectStatusPhase, bool, error) {
+		resp, err := sec.client.GetSecurityProjectStatusWithResponse(ctx, id)
+		if err != nil {
+			return "", false, err
+		}
+		if resp.StatusCode() == http.StatusNotFound {
+			return "", false, nil
+		}
+		if resp.JSON200 == nil {
+			return "", false, fmt.Errorf("failed to get security_project status: %d %s\n%s",
+				resp.StatusCode(), resp.Status(), resp.Body)
+		}
+		return resp.JSON200.Phase, true, nil
+	}, model.Id.ValueString())
+}
+
 func flattenSecurityLinked(ctx context.Context, linked *serverless.LinkConfiguration) (resource_security_project.LinkedValue, diag.Diagnostics) {
 	if linked == nil || len(linked.Projects) == 0 {
 		return resource_security_project.NewLinkedValueNull(), nil
@@ -578,3 +605,33 @@ func expandLinkedForPatchSecurity(plan, state resource_security_project.Security
 		}
 	})
 }
+
+func (sec securityApi) ValidateLinkedProjects(ctx context.Context, plan, state resource_security_project.SecurityProjectModel) diag.Diagnostics {
+	if !util.IsKnown(plan.Id) || !util.IsKnown(plan.Linked) || plan.Linked.IsNull() {
+		return nil
+	}
+
+	var stateProjects basetypes.MapValue
+	if util.IsKnown(state.Linked) && !state.Linked.IsNull() {
+		stateProjects = state.Linked.Projects
+	}
+
+	projects := linkedProjectsToValidate(plan.Linked.Projects, stateProjects, func(v attr.Value) types.String {
+		pv, ok := v.(resource_security_project.ProjectsValue)
+		if !ok {
+			return types.StringUnknown()
+		}
+		return pv.ProjectsType
+	})
+
+	return validateLinkedProjects(ctx, projects, func(ctx context.Context) ([]serverless.LinkedCandidateProject, error) {
+		resp, err := sec.client.GetSecurityProjectLinkCandidatesWithResponse(ctx, plan.Id.ValueString(), nil)
+		if err != nil {
+			return nil, err
+		}
+		if resp.JSON200 == nil {
+			return nil, linkCandidatesError("security", resp.StatusCode(), resp.Status(), resp.Body)
+		}
+		return resp.JSON200.Items, nil
+	})
+}
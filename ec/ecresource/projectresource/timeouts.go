@@ -0,0 +1,78 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package projectresource
+
+import (
+	"time"
+
+	"github.com/elastic/terraform-provider-ec/ec/internal/util"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	defaultCreateTimeout = 30 * time.Minute
+	defaultUpdateTimeout = 30 * time.Minute
+	defaultDeleteTimeout = 30 * time.Minute
+
+	// defaultPollInterval is the interval between project status polls while
+	// waiting for a project to become initialised or to be deleted. Both take
+	// on the order of seconds to minutes, so a 5s interval avoids burning
+	// Serverless API rate-limit quota unnecessarily.
+	defaultPollInterval = 5 * time.Second
+)
+
+// projectTimeouts holds the values of the timeouts block, which is generated
+// separately for each project type.
+type projectTimeouts struct {
+	Create types.String
+	Update types.String
+	Delete types.String
+
+	// PollInterval is the interval between project status polls.
+	PollInterval types.String
+}
+
+func (t projectTimeouts) create() time.Duration {
+	return durationOrDefault(t.Create, defaultCreateTimeout)
+}
+
+func (t projectTimeouts) update() time.Duration {
+	return durationOrDefault(t.Update, defaultUpdateTimeout)
+}
+
+func (t projectTimeouts) delete() time.Duration {
+	return durationOrDefault(t.Delete, defaultDeleteTimeout)
+}
+
+func (t projectTimeouts) pollInterval() time.Duration {
+	return durationOrDefault(t.PollInterval, defaultPollInterval)
+}
+
+// durationOrDefault parses the configured duration. Invalid durations are
+// rejected by the schema validators, so they simply fall back to the default.
+func durationOrDefault(value types.String, fallback time.Duration) time.Duration {
+	if !util.IsKnown(value) {
+		return fallback
+	}
+
+	d, err := time.ParseDuration(value.ValueString())
+	if err != nil || d <= 0 {
+		return fallback
+	}
+	return d
+}
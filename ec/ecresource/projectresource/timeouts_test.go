@@ -0,0 +1,93 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package projectresource
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// withDeadline matches the contexts the CRUD operations derive from their timeouts.
+func withDeadline() gomock.Matcher {
+	return gomock.Cond(func(ctx context.Context) bool {
+		_, ok := ctx.Deadline()
+		return ok
+	})
+}
+
+func TestProjectTimeouts(t *testing.T) {
+	tests := []struct {
+		name     string
+		timeouts projectTimeouts
+		create   time.Duration
+		update   time.Duration
+		delete   time.Duration
+		poll     time.Duration
+	}{
+		{
+			name:     "defaults are used when nothing is configured",
+			timeouts: projectTimeouts{},
+			create:   defaultCreateTimeout,
+			update:   defaultUpdateTimeout,
+			delete:   defaultDeleteTimeout,
+			poll:     defaultPollInterval,
+		},
+		{
+			name: "configured durations are used",
+			timeouts: projectTimeouts{
+				Create: types.StringValue("1h"),
+				Update: types.StringValue("10m"),
+				Delete: types.StringValue("45m30s"),
+
+				PollInterval: types.StringValue("30s"),
+			},
+			create: time.Hour,
+			update: 10 * time.Minute,
+			delete: 45*time.Minute + 30*time.Second,
+			poll:   30 * time.Second,
+		},
+		{
+			name: "unknown and invalid durations fall back to the defaults",
+			timeouts: projectTimeouts{
+				Create: types.StringUnknown(),
+				Update: types.StringValue("soon"),
+				Delete: types.StringValue("-1m"),
+
+				PollInterval: types.StringValue("0s"),
+			},
+			create: defaultCreateTimeout,
+			update: defaultUpdateTimeout,
+			delete: defaultDeleteTimeout,
+			poll:   defaultPollInterval,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.create, tt.timeouts.create())
+			require.Equal(t, tt.update, tt.timeouts.update())
+			require.Equal(t, tt.delete, tt.timeouts.delete())
+			require.Equal(t, tt.poll, tt.timeouts.pollInterval())
+		})
+	}
+}
@@ -46,6 +46,9 @@ func (r *Resource[T]) Update(ctx context.Context, request resource.UpdateRequest
 		stateVal = *stateModel
 	}
 
+	ctx, cancel := context.WithTimeout(ctx, r.modelHandler.GetTimeouts(*planModel).update())
+	defer cancel()
+
 	response.Diagnostics.Append(r.api.Patch(ctx, *planModel, stateVal)...)
 	found, readModel, diags := r.api.Read(ctx, r.modelHandler.GetID(*planModel), *planModel)
 	response.Diagnostics.Append(diags...)
@@ -94,12 +94,13 @@ func TestUpdate(t *testing.T) {
 				modelHandler := NewMockmodelHandler[resource_elasticsearch_project.ElasticsearchProjectModel](ctrl)
 				modelHandler.EXPECT().ReadFrom(ctx, req.Plan).Return(&model, nil)
 				modelHandler.EXPECT().ReadFrom(ctx, req.State).Return(&model, nil)
+				modelHandler.EXPECT().GetTimeouts(model).Return(projectTimeouts{})
 				modelHandler.EXPECT().GetID(model).Return(model.Id.ValueString())
 
 				api := NewMockapi[resource_elasticsearch_project.ElasticsearchProjectModel](ctrl)
 				api.EXPECT().Ready().Return(true)
-				api.EXPECT().Patch(ctx, model, model).Return(nil)
-				api.EXPECT().Read(ctx, model.Id.ValueString(), model).Return(false, model, nil)
+				api.EXPECT().Patch(withDeadline(), model, model).Return(nil)
+				api.EXPECT().Read(withDeadline(), model.Id.ValueString(), model).Return(false, model, nil)
 
 				return testData{
 					modelHandler: modelHandler,
@@ -136,12 +137,13 @@ func TestUpdate(t *testing.T) {
 				modelHandler := NewMockmodelHandler[resource_elasticsearch_project.ElasticsearchProjectModel](ctrl)
 				modelHandler.EXPECT().ReadFrom(ctx, req.Plan).Return(&model, nil)
 				modelHandler.EXPECT().ReadFrom(ctx, req.State).Return(&model, nil)
+				modelHandler.EXPECT().GetTimeouts(model).Return(projectTimeouts{})
 				modelHandler.EXPECT().GetID(model).Return(model.Id.ValueString())
 
 				api := NewMockapi[resource_elasticsearch_project.ElasticsearchProjectModel](ctrl)
 				api.EXPECT().Ready().Return(true)
-				api.EXPECT().Patch(ctx, model, model).Return(nil)
-				api.EXPECT().Read(ctx, model.Id.ValueString(), model).Return(true, readModel, nil)
+				api.EXPECT().Patch(withDeadline(), model, model).Return(nil)
+				api.EXPECT().Read(withDeadline(), model.Id.ValueString(), model).Return(true, readModel, nil)
 
 				return testData{
 					modelHandler: modelHandler,
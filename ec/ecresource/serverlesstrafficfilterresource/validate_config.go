@@ -0,0 +1,63 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package serverlesstrafficfilterresource
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/elastic/terraform-provider-ec/ec/internal/gen/serverless/resource_serverless_traffic_filter"
+	"github.com/elastic/terraform-provider-ec/ec/internal/validators"
+)
+
+var _ resource.ResourceWithValidateConfig = &Resource{}
+
+func (r *Resource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var config resource_serverless_traffic_filter.ServerlessTrafficFilterModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &config)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Skip validation if type or rules are unknown (e.g., during plan with variables)
+	if config.Type.IsUnknown() || config.Type.IsNull() || config.Rules.IsUnknown() || config.Rules.IsNull() {
+		return
+	}
+
+	var rules []resource_serverless_traffic_filter.RulesValue
+	response.Diagnostics.Append(config.Rules.ElementsAs(ctx, &rules, false)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	validatorRules := make([]validators.TrafficFilterRule, 0, len(rules))
+	for i, rule := range rules {
+		if rule.IsNull() || rule.IsUnknown() {
+			continue
+		}
+
+		validatorRules = append(validatorRules, validators.TrafficFilterRule{
+			Path:   path.Root("rules").AtListIndex(i),
+			Source: rule.Source,
+		})
+	}
+
+	response.Diagnostics.Append(validators.ValidateServerlessTrafficFilterRules(config.Type.ValueString(), validatorRules)...)
+}
@@ -0,0 +1,80 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package snapshotrepositoryresource
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/elastic/cloud-sdk-go/pkg/api/platformapi/snaprepoapi"
+	"github.com/elastic/cloud-sdk-go/pkg/util"
+)
+
+// expandRepository returns the repository type and settings to send to the API.
+func expandRepository(state modelV0) (string, util.Validator, error) {
+	switch {
+	case state.S3 != nil:
+		return "s3", snaprepoapi.S3Config{
+			Region:               state.S3.Region.ValueString(),
+			Bucket:               state.S3.Bucket.ValueString(),
+			AccessKey:            state.S3.AccessKey.ValueString(),
+			SecretKey:            state.S3.SecretKey.ValueString(),
+			ServerSideEncryption: state.S3.ServerSideEncryption.ValueBool(),
+			Endpoint:             state.S3.Endpoint.ValueString(),
+			PathStyleAccess:      state.S3.PathStyleAccess.ValueBool(),
+		}, nil
+	case state.GCS != nil:
+		settings := snaprepoapi.GenericConfig{
+			"bucket": state.GCS.Bucket.ValueString(),
+		}
+		setString(settings, "client", state.GCS.Client)
+		setString(settings, "base_path", state.GCS.BasePath)
+		setBool(settings, "compress", state.GCS.Compress)
+		setString(settings, "chunk_size", state.GCS.ChunkSize)
+		return "gcs", settings, nil
+	case state.Azure != nil:
+		settings := snaprepoapi.GenericConfig{
+			"container": state.Azure.Container.ValueString(),
+		}
+		setString(settings, "client", state.Azure.Client)
+		setString(settings, "base_path", state.Azure.BasePath)
+		setBool(settings, "compress", state.Azure.Compress)
+		setString(settings, "chunk_size", state.Azure.ChunkSize)
+		setString(settings, "location_mode", state.Azure.LocationMode)
+		return "azure", settings, nil
+	default:
+		settings, err := snaprepoapi.ParseGenericConfig(strings.NewReader(state.Generic.Settings.ValueString()))
+		if err != nil {
+			return "", nil, err
+		}
+		return state.Generic.Type.ValueString(), settings, nil
+	}
+}
+
+func setString(settings snaprepoapi.GenericConfig, key string, value types.String) {
+	if !value.IsNull() && !value.IsUnknown() {
+		settings[key] = value.ValueString()
+	}
+}
+
+func setBool(settings snaprepoapi.GenericConfig, key string, value types.Bool) {
+	if !value.IsNull() && !value.IsUnknown() {
+		settings[key] = value.ValueBool()
+	}
+}
@@ -0,0 +1,90 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package snapshotrepositoryresource
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/cloud-sdk-go/pkg/api/platformapi/snaprepoapi"
+	"github.com/elastic/cloud-sdk-go/pkg/util"
+)
+
+func Test_expandRepository(t *testing.T) {
+	tests := []struct {
+		name       string
+		state      modelV0
+		wantType   string
+		wantConfig util.Validator
+	}{
+		{
+			name: "expands a gcs repository",
+			state: modelV0{GCS: &gcsRepositoryV0{
+				Bucket:    types.StringValue("my-bucket"),
+				Client:    types.StringValue("my_client"),
+				BasePath:  types.StringNull(),
+				Compress:  types.BoolValue(false),
+				ChunkSize: types.StringValue("1gb"),
+			}},
+			wantType: "gcs",
+			wantConfig: snaprepoapi.GenericConfig{
+				"bucket":     "my-bucket",
+				"client":     "my_client",
+				"compress":   false,
+				"chunk_size": "1gb",
+			},
+		},
+		{
+			name: "expands an azure repository",
+			state: modelV0{Azure: &azureRepositoryV0{
+				Container:    types.StringValue("my-container"),
+				Client:       types.StringNull(),
+				BasePath:     types.StringValue("snapshots"),
+				Compress:     types.BoolNull(),
+				ChunkSize:    types.StringNull(),
+				LocationMode: types.StringValue("primary_only"),
+			}},
+			wantType: "azure",
+			wantConfig: snaprepoapi.GenericConfig{
+				"container":     "my-container",
+				"base_path":     "snapshots",
+				"location_mode": "primary_only",
+			},
+		},
+		{
+			name: "expands a generic repository",
+			state: modelV0{Generic: &genericRepositoryV0{
+				Type:     types.StringValue("hdfs"),
+				Settings: types.StringValue(`{"uri":"hdfs://namenode:8020/"}`),
+			}},
+			wantType:   "hdfs",
+			wantConfig: snaprepoapi.GenericConfig{"uri": "hdfs://namenode:8020/"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotType, gotConfig, err := expandRepository(tt.state)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantType, gotType)
+			assert.Equal(t, tt.wantConfig, gotConfig)
+		})
+	}
+}
@@ -21,9 +21,12 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"reflect"
+	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/elastic/cloud-sdk-go/pkg/api/apierror"
@@ -85,8 +88,11 @@ func modelToState(model *models.RepositoryConfig, state *modelV0) diag.Diagnosti
 	if repositoryType, ok := config["type"]; ok && repositoryType != nil {
 		if settingsInterface, ok := config["settings"]; ok && settingsInterface != nil {
 			settings := settingsInterface.(map[string]any)
-			// Parse into S3 schema if possible, but fall back to Generic when custom settings have been used.
-			if repositoryType.(string) == "s3" && containsOnlyKnownS3Settings(settings) {
+			// Parse into the typed schemas if possible, but fall back to Generic when custom settings have been used,
+			// or when the repository is already managed through the generic settings.
+			switch {
+			case repositoryType.(string) == "s3" && state.Generic == nil && containsOnlyKnownSettings(settings, s3Schema()):
+				state.GCS, state.Azure = nil, nil
 				if state.S3 == nil {
 					state.S3 = &s3RepositoryV0{}
 				}
@@ -111,11 +117,37 @@ func modelToState(model *models.RepositoryConfig, state *modelV0) diag.Diagnosti
 				if pathStyleAccess, ok := settings["path_style_access"]; ok && pathStyleAccess != nil {
 					state.S3.PathStyleAccess = types.BoolValue(pathStyleAccess.(bool))
 				}
-			} else {
+			case repositoryType.(string) == "gcs" && state.Generic == nil && containsOnlyKnownSettings(settings, gcsSchema()):
+				state.S3, state.Azure = nil, nil
+				state.GCS = &gcsRepositoryV0{
+					Bucket:    stringSetting(settings, "bucket"),
+					Client:    stringSetting(settings, "client"),
+					BasePath:  stringSetting(settings, "base_path"),
+					Compress:  boolSetting(settings, "compress"),
+					ChunkSize: stringSetting(settings, "chunk_size"),
+				}
+			case repositoryType.(string) == "azure" && state.Generic == nil && containsOnlyKnownSettings(settings, azureSchema()):
+				state.S3, state.GCS = nil, nil
+				state.Azure = &azureRepositoryV0{
+					Container:    stringSetting(settings, "container"),
+					Client:       stringSetting(settings, "client"),
+					BasePath:     stringSetting(settings, "base_path"),
+					Compress:     boolSetting(settings, "compress"),
+					ChunkSize:    stringSetting(settings, "chunk_size"),
+					LocationMode: stringSetting(settings, "location_mode"),
+				}
+			default:
+				state.S3, state.GCS, state.Azure = nil, nil, nil
 				if state.Generic == nil {
 					state.Generic = &genericRepositoryV0{}
 				}
 				state.Generic.Type = types.StringValue(repositoryType.(string))
+
+				// Keep the configured JSON when it's equivalent to the remote settings, to avoid formatting only diffs.
+				if settingsEqual(state.Generic.Settings, settings) {
+					break
+				}
+
 				jsonSettings, err := json.Marshal(settings)
 				if err != nil {
 					diags.AddError(
@@ -131,8 +163,8 @@ func modelToState(model *models.RepositoryConfig, state *modelV0) diag.Diagnosti
 	return diags
 }
 
-func containsOnlyKnownS3Settings(settings map[string]any) bool {
-	attributes := s3Schema().GetType().(types.ObjectType).AttributeTypes()
+func containsOnlyKnownSettings(settings map[string]any, repositorySchema schema.Attribute) bool {
+	attributes := repositorySchema.GetType().(types.ObjectType).AttributeTypes()
 	for key := range settings {
 		if _, ok := attributes[key]; !ok {
 			return false
@@ -140,3 +172,46 @@ func containsOnlyKnownS3Settings(settings map[string]any) bool {
 	}
 	return true
 }
+
+// stringSetting returns the setting as a string. The API may return scalar settings with a different type than the
+// one they were set with (e.g. a chunk size as a number), so they're converted rather than asserted.
+func stringSetting(settings map[string]any, key string) types.String {
+	value, ok := settings[key]
+	if !ok || value == nil {
+		return types.StringNull()
+	}
+	if str, ok := value.(string); ok {
+		return types.StringValue(str)
+	}
+	return types.StringValue(fmt.Sprint(value))
+}
+
+func boolSetting(settings map[string]any, key string) types.Bool {
+	value, ok := settings[key]
+	if !ok || value == nil {
+		return types.BoolNull()
+	}
+	switch v := value.(type) {
+	case bool:
+		return types.BoolValue(v)
+	case string:
+		if b, err := strconv.ParseBool(v); err == nil {
+			return types.BoolValue(b)
+		}
+	}
+	return types.BoolNull()
+}
+
+// settingsEqual returns true when the JSON settings decode to the same value as the given settings.
+func settingsEqual(jsonSettings types.String, settings map[string]any) bool {
+	if jsonSettings.IsNull() || jsonSettings.IsUnknown() {
+		return false
+	}
+
+	var decoded map[string]any
+	if err := json.Unmarshal([]byte(jsonSettings.ValueString()), &decoded); err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(decoded, settings)
+}
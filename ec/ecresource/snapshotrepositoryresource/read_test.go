@@ -0,0 +1,147 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package snapshotrepositoryresource
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/cloud-sdk-go/pkg/models"
+)
+
+func Test_modelToState(t *testing.T) {
+	repository := func(repositoryType string, settings map[string]any) *models.RepositoryConfig {
+		return &models.RepositoryConfig{
+			RepositoryName: new("my-snapshot-repository"),
+			Config: map[string]any{
+				"type":     repositoryType,
+				"settings": settings,
+			},
+		}
+	}
+
+	tests := []struct {
+		name  string
+		model *models.RepositoryConfig
+		state modelV0
+		want  modelV0
+	}{
+		{
+			name: "reads a gcs repository",
+			model: repository("gcs", map[string]any{
+				"bucket":     "my-bucket",
+				"client":     "my_client",
+				"base_path":  "snapshots",
+				"compress":   true,
+				"chunk_size": "100mb",
+			}),
+			want: modelV0{
+				Name: types.StringValue("my-snapshot-repository"),
+				GCS: &gcsRepositoryV0{
+					Bucket:    types.StringValue("my-bucket"),
+					Client:    types.StringValue("my_client"),
+					BasePath:  types.StringValue("snapshots"),
+					Compress:  types.BoolValue(true),
+					ChunkSize: types.StringValue("100mb"),
+				},
+			},
+		},
+		{
+			name: "reads an azure repository with settings returned as strings",
+			model: repository("azure", map[string]any{
+				"container":     "my-container",
+				"compress":      "false",
+				"location_mode": "secondary_only",
+			}),
+			want: modelV0{
+				Name: types.StringValue("my-snapshot-repository"),
+				Azure: &azureRepositoryV0{
+					Container:    types.StringValue("my-container"),
+					Client:       types.StringNull(),
+					BasePath:     types.StringNull(),
+					Compress:     types.BoolValue(false),
+					ChunkSize:    types.StringNull(),
+					LocationMode: types.StringValue("secondary_only"),
+				},
+			},
+		},
+		{
+			name: "falls back to generic settings for unknown settings",
+			model: repository("azure", map[string]any{
+				"container":                 "my-container",
+				"max_restore_bytes_per_sec": "40mb",
+			}),
+			want: modelV0{
+				Name: types.StringValue("my-snapshot-repository"),
+				Generic: &genericRepositoryV0{
+					Type:     types.StringValue("azure"),
+					Settings: types.StringValue(`{"container":"my-container","max_restore_bytes_per_sec":"40mb"}`),
+				},
+			},
+		},
+		{
+			name: "keeps managing an existing generic repository with the generic settings",
+			model: repository("gcs", map[string]any{
+				"bucket": "my-bucket",
+			}),
+			state: modelV0{
+				Generic: &genericRepositoryV0{
+					Type:     types.StringValue("gcs"),
+					Settings: types.StringValue("{\n  \"bucket\": \"my-bucket\"\n}"),
+				},
+			},
+			want: modelV0{
+				Name: types.StringValue("my-snapshot-repository"),
+				Generic: &genericRepositoryV0{
+					Type:     types.StringValue("gcs"),
+					Settings: types.StringValue("{\n  \"bucket\": \"my-bucket\"\n}"),
+				},
+			},
+		},
+		{
+			name: "updates the generic settings when they changed",
+			model: repository("gcs", map[string]any{
+				"bucket": "my-other-bucket",
+			}),
+			state: modelV0{
+				Generic: &genericRepositoryV0{
+					Type:     types.StringValue("gcs"),
+					Settings: types.StringValue(`{"bucket":"my-bucket"}`),
+				},
+			},
+			want: modelV0{
+				Name: types.StringValue("my-snapshot-repository"),
+				Generic: &genericRepositoryV0{
+					Type:     types.StringValue("gcs"),
+					Settings: types.StringValue(`{"bucket":"my-other-bucket"}`),
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := tt.state
+			diags := modelToState(tt.model, &state)
+			require.False(t, diags.HasError(), diags)
+			assert.Equal(t, tt.want, state)
+		})
+	}
+}
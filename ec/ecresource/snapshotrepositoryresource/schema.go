@@ -19,14 +19,18 @@ package snapshotrepositoryresource
 
 import (
 	"context"
+	"fmt"
+	"regexp"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/elastic/cloud-sdk-go/pkg/api"
@@ -62,6 +66,8 @@ func (r *Resource) Schema(_ context.Context, _ resource.SchemaRequest, resp *res
 			},
 			"generic": genericSchema(),
 			"s3":      s3Schema(),
+			"gcs":     gcsSchema(),
+			"azure":   azureSchema(),
 		},
 	}
 }
@@ -112,6 +118,93 @@ func s3Schema() schema.Attribute {
 	}
 }
 
+func gcsSchema() schema.Attribute {
+	return schema.SingleNestedAttribute{
+		Description: "Google Cloud Storage repository settings.",
+		Optional:    true,
+		Attributes: map[string]schema.Attribute{
+			"bucket": schema.StringAttribute{
+				Description: "Name of the Google Cloud Storage bucket to use for snapshots.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 222),
+					stringvalidator.RegexMatches(gcsBucketRegex, "must only contain lowercase letters, numbers, dashes, underscores and dots, and start and end with a letter or a number"),
+				},
+			},
+			"client":     clientSchema(),
+			"base_path":  basePathSchema("bucket"),
+			"compress":   compressSchema(),
+			"chunk_size": chunkSizeSchema(),
+		},
+	}
+}
+
+func azureSchema() schema.Attribute {
+	return schema.SingleNestedAttribute{
+		Description: "Azure Blob Storage repository settings.",
+		Optional:    true,
+		Attributes: map[string]schema.Attribute{
+			"container": schema.StringAttribute{
+				Description: "Name of the Azure Blob Storage container to use for snapshots.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 63),
+					stringvalidator.RegexMatches(azureContainerRegex, "must only contain lowercase letters, numbers and single dashes, and start and end with a letter or a number"),
+				},
+			},
+			"client":     clientSchema(),
+			"base_path":  basePathSchema("container"),
+			"compress":   compressSchema(),
+			"chunk_size": chunkSizeSchema(),
+			"location_mode": schema.StringAttribute{
+				Description: "The storage location to use, `primary_only` or `secondary_only`. `secondary_only` makes the repository read-only. Defaults to `primary_only` when unset.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("primary_only", "secondary_only"),
+				},
+			},
+		},
+	}
+}
+
+func clientSchema() schema.Attribute {
+	return schema.StringAttribute{
+		Description: "The name of the client to use to connect to the storage service, as configured in the Elasticsearch keystore. Defaults to `default` when unset.",
+		Optional:    true,
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+	}
+}
+
+func basePathSchema(container string) schema.Attribute {
+	return schema.StringAttribute{
+		Description: fmt.Sprintf("The path within the %s to store snapshots in. Defaults to the root of the %s.", container, container),
+		Optional:    true,
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+			stringvalidator.RegexMatches(basePathRegex, "must not start with a slash"),
+		},
+	}
+}
+
+func compressSchema() schema.Attribute {
+	return schema.BoolAttribute{
+		Description: "When set to true metadata files are stored in compressed format. Defaults to true when unset.",
+		Optional:    true,
+	}
+}
+
+func chunkSizeSchema() schema.Attribute {
+	return schema.StringAttribute{
+		Description: "Big files are broken down into chunks of this size during snapshotting, as a byte size value (e.g `100mb`, `1gb`).",
+		Optional:    true,
+		Validators: []validator.String{
+			stringvalidator.RegexMatches(chunkSizeRegex, "must be a byte size value, e.g. 100mb or 1gb"),
+		},
+	}
+}
+
 func genericSchema() schema.Attribute {
 	return schema.SingleNestedAttribute{
 		Description: "Generic repository settings.",
@@ -129,6 +222,13 @@ func genericSchema() schema.Attribute {
 	}
 }
 
+var (
+	gcsBucketRegex      = regexp.MustCompile(`^[a-z0-9][a-z0-9._-]*[a-z0-9]$`)
+	azureContainerRegex = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+	basePathRegex       = regexp.MustCompile(`^[^/]`)
+	chunkSizeRegex      = regexp.MustCompile(`^(?i)[0-9]+(b|kb|mb|gb|tb|pb)$`)
+)
+
 type Resource struct {
 	client *api.API
 }
@@ -138,6 +238,8 @@ func (r *Resource) ConfigValidators(ctx context.Context) []resource.ConfigValida
 		resourcevalidator.ExactlyOneOf(
 			path.MatchRoot("generic"),
 			path.MatchRoot("s3"),
+			path.MatchRoot("gcs"),
+			path.MatchRoot("azure"),
 		),
 	}
 }
@@ -152,8 +254,10 @@ func (r *Resource) Metadata(ctx context.Context, request resource.MetadataReques
 	response.TypeName = request.ProviderTypeName + "_snapshot_repository"
 }
 
+// ImportState imports a snapshot repository by name, such as the repositories created by the ECE installer.
 func (r *Resource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), request, response)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("name"), request.ID)...)
 }
 
 func resourceReady(r *Resource, dg *diag.Diagnostics) bool {
@@ -172,6 +276,8 @@ type modelV0 struct {
 	ID      types.String         `tfsdk:"id"`
 	Name    types.String         `tfsdk:"name"`
 	S3      *s3RepositoryV0      `tfsdk:"s3"`
+	GCS     *gcsRepositoryV0     `tfsdk:"gcs"`
+	Azure   *azureRepositoryV0   `tfsdk:"azure"`
 	Generic *genericRepositoryV0 `tfsdk:"generic"`
 }
 
@@ -184,6 +290,24 @@ type s3RepositoryV0 struct {
 	Endpoint             types.String `tfsdk:"endpoint"`
 	PathStyleAccess      types.Bool   `tfsdk:"path_style_access"`
 }
+
+type gcsRepositoryV0 struct {
+	Bucket    types.String `tfsdk:"bucket"`
+	Client    types.String `tfsdk:"client"`
+	BasePath  types.String `tfsdk:"base_path"`
+	Compress  types.Bool   `tfsdk:"compress"`
+	ChunkSize types.String `tfsdk:"chunk_size"`
+}
+
+type azureRepositoryV0 struct {
+	Container    types.String `tfsdk:"container"`
+	Client       types.String `tfsdk:"client"`
+	BasePath     types.String `tfsdk:"base_path"`
+	Compress     types.Bool   `tfsdk:"compress"`
+	ChunkSize    types.String `tfsdk:"chunk_size"`
+	LocationMode types.String `tfsdk:"location_mode"`
+}
+
 type genericRepositoryV0 struct {
 	Type     types.String `tfsdk:"type"`
 	Settings types.String `tfsdk:"settings"`
@@ -19,12 +19,10 @@ package snapshotrepositoryresource
 
 import (
 	"context"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 
 	"github.com/elastic/cloud-sdk-go/pkg/api/platformapi/snaprepoapi"
-	"github.com/elastic/cloud-sdk-go/pkg/util"
 )
 
 func (r *Resource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
@@ -40,30 +38,13 @@ func (r *Resource) Update(ctx context.Context, request resource.UpdateRequest, r
 		return
 	}
 
-	var repositoryType string
-	var repositoryConfig util.Validator
-	if newState.S3 != nil {
-		repositoryType = "s3"
-		repositoryConfig = snaprepoapi.S3Config{
-			Region:               newState.S3.Region.ValueString(),
-			Bucket:               newState.S3.Bucket.ValueString(),
-			AccessKey:            newState.S3.AccessKey.ValueString(),
-			SecretKey:            newState.S3.SecretKey.ValueString(),
-			ServerSideEncryption: newState.S3.ServerSideEncryption.ValueBool(),
-			Endpoint:             newState.S3.Endpoint.ValueString(),
-			PathStyleAccess:      newState.S3.PathStyleAccess.ValueBool(),
-		}
-	} else {
-		var err error
-		repositoryType = newState.Generic.Type.ValueString()
-		repositoryConfig, err = snaprepoapi.ParseGenericConfig(strings.NewReader(newState.Generic.Settings.ValueString()))
-		if err != nil {
-			response.Diagnostics.AddError(err.Error(), err.Error())
-			return
-		}
+	repositoryType, repositoryConfig, err := expandRepository(newState)
+	if err != nil {
+		response.Diagnostics.AddError(err.Error(), err.Error())
+		return
 	}
 
-	err := snaprepoapi.Set(
+	err = snaprepoapi.Set(
 		snaprepoapi.SetParams{
 			API:    r.client,
 			Region: "ece-region", // This resource is only usable for ECE installations. Thus, we can default to ece-region.
@@ -0,0 +1,185 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package trafficfilterassocsresource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/elastic/cloud-sdk-go/pkg/api/deploymentapi/trafficfilterapi"
+	"github.com/elastic/cloud-sdk-go/pkg/client/deployments_traffic_filter"
+	"github.com/elastic/cloud-sdk-go/pkg/models"
+
+	"github.com/elastic/terraform-provider-ec/ec/internal/util"
+)
+
+const entityTypeDeployment = "deployment"
+
+// maxConcurrentRequests bounds the number of associations created or deleted
+// at the same time.
+const maxConcurrentRequests = 8
+
+// readAssociations returns the IDs of the deployments associated to the
+// ruleset, sorted. found is false when the ruleset doesn't exist.
+func (r Resource) readAssociations(rulesetID string) (deploymentIDs []string, found bool, err error) {
+	res, err := trafficfilterapi.Get(trafficfilterapi.GetParams{
+		API:                 r.client,
+		ID:                  rulesetID,
+		IncludeAssociations: true,
+	})
+	if err != nil {
+		if util.TrafficFilterNotFound(err) {
+			return nil, false, nil
+		}
+		return nil, true, err
+	}
+	if res == nil {
+		return nil, false, nil
+	}
+
+	return deploymentAssociations(res), true, nil
+}
+
+func deploymentAssociations(res *models.TrafficFilterRulesetInfo) []string {
+	var deploymentIDs []string
+	for _, assoc := range res.Associations {
+		if assoc.EntityType != nil && *assoc.EntityType == entityTypeDeployment && assoc.ID != nil {
+			deploymentIDs = append(deploymentIDs, *assoc.ID)
+		}
+	}
+	slices.Sort(deploymentIDs)
+	return slices.Compact(deploymentIDs)
+}
+
+// diffAssociations returns the deployments to associate and to disassociate
+// to go from current to desired.
+func diffAssociations(current, desired []string) (additions, removals []string) {
+	for _, id := range desired {
+		if !slices.Contains(current, id) {
+			additions = append(additions, id)
+		}
+	}
+	for _, id := range current {
+		if !slices.Contains(desired, id) {
+			removals = append(removals, id)
+		}
+	}
+	slices.Sort(additions)
+	slices.Sort(removals)
+	return additions, removals
+}
+
+// applyAssociations associates the ruleset to the deployments of desired which
+// aren't in current yet, and disassociates it from the other deployments of
+// current, running the API calls concurrently.
+//
+// It returns the deployments which are associated once done, so that failed
+// calls are left to the next apply.
+func (r Resource) applyAssociations(rulesetID string, current, desired []string) ([]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	current = slices.Clone(current)
+	additions, removals := diffAssociations(current, desired)
+
+	errs := util.ForEachConcurrently(additions, maxConcurrentRequests, func(deploymentID string) error {
+		return trafficfilterapi.CreateAssociation(trafficfilterapi.CreateAssociationParams{
+			API:        r.client,
+			ID:         rulesetID,
+			EntityID:   deploymentID,
+			EntityType: entityTypeDeployment,
+		})
+	})
+	for i, err := range errs {
+		if err != nil {
+			diags.AddError(
+				fmt.Sprintf("Failed to associate the traffic filter ruleset to deployment %s", additions[i]),
+				err.Error(),
+			)
+			continue
+		}
+		current = append(current, additions[i])
+	}
+
+	errs = util.ForEachConcurrently(removals, maxConcurrentRequests, func(deploymentID string) error {
+		err := trafficfilterapi.DeleteAssociation(trafficfilterapi.DeleteAssociationParams{
+			API:        r.client,
+			ID:         rulesetID,
+			EntityID:   deploymentID,
+			EntityType: entityTypeDeployment,
+		})
+		if associationDeleted(err) {
+			return nil
+		}
+		return err
+	})
+	for i, err := range errs {
+		if err != nil {
+			diags.AddError(
+				fmt.Sprintf("Failed to disassociate the traffic filter ruleset from deployment %s", removals[i]),
+				err.Error(),
+			)
+			continue
+		}
+		current = slices.DeleteFunc(current, func(id string) bool { return id == removals[i] })
+	}
+
+	slices.Sort(current)
+	return current, diags
+}
+
+func associationDeleted(err error) bool {
+	var notFound *deployments_traffic_filter.DeleteTrafficFilterRulesetAssociationNotFound
+	return errors.As(err, &notFound)
+}
+
+// reconcile reads the deployments associated to the ruleset and applies the
+// desired associations.
+func (r Resource) reconcile(rulesetID string, desired []string) ([]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	current, found, err := r.readAssociations(rulesetID)
+	if err != nil {
+		diags.AddError(err.Error(), err.Error())
+		return nil, diags
+	}
+	if !found {
+		diags.AddError(
+			"Traffic filter ruleset not found",
+			fmt.Sprintf("The traffic filter ruleset %s doesn't exist.", rulesetID),
+		)
+		return nil, diags
+	}
+
+	return r.applyAssociations(rulesetID, current, desired)
+}
+
+// setAssociations sets the associated deployments in the state.
+func setAssociations(ctx context.Context, state *modelV0, deploymentIDs []string) diag.Diagnostics {
+	if deploymentIDs == nil {
+		deploymentIDs = []string{}
+	}
+	ids, diags := types.SetValueFrom(ctx, types.StringType, deploymentIDs)
+	state.DeploymentIDs = ids
+	state.ID = state.TrafficFilterID
+	return diags
+}
@@ -0,0 +1,86 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package trafficfilterassocsresource
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/cloud-sdk-go/pkg/api"
+	"github.com/elastic/cloud-sdk-go/pkg/api/mock"
+	"github.com/elastic/cloud-sdk-go/pkg/models"
+)
+
+func Test_diffAssociations(t *testing.T) {
+	additions, removals := diffAssociations([]string{"a", "b", "c"}, []string{"d", "b", "e"})
+	assert.Equal(t, []string{"d", "e"}, additions)
+	assert.Equal(t, []string{"a", "c"}, removals)
+
+	additions, removals = diffAssociations([]string{"a"}, []string{"a"})
+	assert.Empty(t, additions)
+	assert.Empty(t, removals)
+}
+
+func Test_deploymentAssociations(t *testing.T) {
+	got := deploymentAssociations(&models.TrafficFilterRulesetInfo{
+		Associations: []*models.FilterAssociation{
+			{EntityType: new("deployment"), ID: new("b")},
+			{EntityType: new("cluster"), ID: new("c")},
+			{EntityType: new("deployment"), ID: new("a")},
+		},
+	})
+	assert.Equal(t, []string{"a", "b"}, got)
+}
+
+func TestResource_applyAssociations(t *testing.T) {
+	const associationsPath = "/api/v1/deployments/traffic-filter/rulesets/ruleset-id/associations"
+
+	t.Run("creates and deletes the associations which differ", func(t *testing.T) {
+		client := api.NewMockMatchingByEndpoint(map[string][]mock.Response{
+			associationsPath + "$": {
+				mock.New200Response(mock.NewStringBody(`{}`)),
+				mock.New200Response(mock.NewStringBody(`{}`)),
+			},
+			associationsPath + "/deployment/": {
+				mock.New200Response(mock.NewStringBody(`{}`)),
+			},
+		})
+
+		got, diags := Resource{client: client}.applyAssociations("ruleset-id", []string{"a", "b"}, []string{"b", "c", "d"})
+		require.False(t, diags.HasError(), diags)
+		assert.Equal(t, []string{"b", "c", "d"}, got)
+	})
+
+	t.Run("only keeps the associations which were applied", func(t *testing.T) {
+		client := api.NewMockMatchingByEndpoint(map[string][]mock.Response{
+			associationsPath + "$": {
+				mock.New500Response(mock.NewStringBody(`{"errors":[{"code":"root.unexpected_error","message":"failed"}]}`)),
+			},
+			associationsPath + "/deployment/": {
+				mock.New200Response(mock.NewStringBody(`{}`)),
+			},
+		})
+
+		got, diags := Resource{client: client}.applyAssociations("ruleset-id", []string{"a", "b"}, []string{"b", "c"})
+		require.True(t, diags.HasError())
+		assert.Equal(t, "Failed to associate the traffic filter ruleset to deployment c", diags[0].Summary())
+		assert.Equal(t, []string{"b"}, got)
+	})
+}
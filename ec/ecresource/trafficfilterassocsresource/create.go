@@ -0,0 +1,55 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package trafficfilterassocsresource
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func (r Resource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	if !resourceReady(r, &response.Diagnostics) {
+		return
+	}
+
+	var newState modelV0
+
+	diags := request.Plan.Get(ctx, &newState)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var desired []string
+	response.Diagnostics.Append(newState.DeploymentIDs.ElementsAs(ctx, &desired, false)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	associated, diags := r.reconcile(newState.TrafficFilterID.ValueString(), desired)
+	response.Diagnostics.Append(diags...)
+	if associated == nil && response.Diagnostics.HasError() {
+		return
+	}
+
+	// The associations which were created are recorded even when others
+	// failed, so that they are managed from now on.
+	response.Diagnostics.Append(setAssociations(ctx, &newState, associated)...)
+	response.Diagnostics.Append(response.State.Set(ctx, newState)...)
+}
@@ -0,0 +1,139 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package trafficfiltergroupresource
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/elastic/cloud-sdk-go/pkg/api/deploymentapi/trafficfilterapi"
+	"github.com/elastic/cloud-sdk-go/pkg/client/deployments_traffic_filter"
+)
+
+// apply creates, updates and deletes the rulesets of the group so that they
+// match the planned shards. Rulesets are only updated when their sources or
+// the group attributes change, and removed rulesets are deleted last so that
+// their sources are already allowed by another ruleset.
+//
+// It returns the shards which exist once done, including the ones left as
+// they were when an API call fails.
+func (r Resource) apply(ctx context.Context, plan modelV0, previous []shard, groupChanged bool) ([]shard, diag.Diagnostics) {
+	planned, diags := expandShards(ctx, plan.Rulesets)
+	if diags.HasError() {
+		return previous, diags
+	}
+
+	current := slices.Clone(previous)
+	indexOf := func(id string) int {
+		return slices.IndexFunc(current, func(s shard) bool { return s.ID == id })
+	}
+
+	for i, s := range planned {
+		if s.ID == "" {
+			res, err := trafficfilterapi.Create(trafficfilterapi.CreateParams{
+				API: r.client, Req: expandRuleset(plan, s),
+			})
+			if err != nil {
+				diags.AddError(err.Error(), err.Error())
+				return current, diags
+			}
+
+			planned[i].ID = *res.ID
+			current = append(current, planned[i])
+			continue
+		}
+
+		index := indexOf(s.ID)
+		if index >= 0 && !groupChanged && shardEqual(current[index], s) {
+			continue
+		}
+
+		if _, err := trafficfilterapi.Update(trafficfilterapi.UpdateParams{
+			API: r.client, ID: s.ID, Req: expandRuleset(plan, s),
+		}); err != nil {
+			diags.AddError(err.Error(), err.Error())
+			return current, diags
+		}
+
+		if index >= 0 {
+			current[index] = s
+		} else {
+			current = append(current, s)
+		}
+	}
+
+	for _, s := range previous {
+		if slices.ContainsFunc(planned, func(p shard) bool { return p.ID == s.ID }) {
+			continue
+		}
+
+		if err := r.deleteRuleset(s.ID); err != nil {
+			diags.AddError(err.Error(), err.Error())
+			return current, diags
+		}
+
+		current = slices.DeleteFunc(current, func(c shard) bool { return c.ID == s.ID })
+	}
+
+	return planned, diags
+}
+
+// deleteRuleset deletes the ruleset of a shard along with its deployment
+// associations. Rulesets which are already gone are ignored.
+func (r Resource) deleteRuleset(id string) error {
+	err := trafficfilterapi.Delete(trafficfilterapi.DeleteParams{
+		API: r.client, ID: id, IgnoreAssociations: true,
+	})
+
+	var notFound *deployments_traffic_filter.DeleteTrafficFilterRulesetNotFound
+	if errors.As(err, &notFound) {
+		return nil
+	}
+	return err
+}
+
+func shardEqual(a, b shard) bool {
+	return a.ID == b.ID && a.Number == b.Number && slices.Equal(a.Sources, b.Sources)
+}
+
+// setShards sets the rulesets of the group in the state. The ID of the group
+// is re-pointed to the first ruleset when its ruleset no longer exists.
+func setShards(state *modelV0, shards []shard) diag.Diagnostics {
+	rulesets, rulesetIDs, diags := flattenShards(state.Name.ValueString(), shards)
+	state.Rulesets = rulesets
+	state.RulesetIDs = rulesetIDs
+	if !hasShard(shards, state.ID) {
+		state.ID = types.StringNull()
+		if len(shards) > 0 {
+			state.ID = types.StringValue(shards[0].ID)
+		}
+	}
+	return diags
+}
+
+// hasShard returns true when one of the shards is held by the ruleset id.
+func hasShard(shards []shard, id types.String) bool {
+	if id.IsUnknown() || id.IsNull() {
+		return false
+	}
+	return slices.ContainsFunc(shards, func(s shard) bool { return s.ID == id.ValueString() })
+}
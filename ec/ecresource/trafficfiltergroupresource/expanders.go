@@ -0,0 +1,156 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package trafficfiltergroupresource
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/elastic/cloud-sdk-go/pkg/models"
+
+	"github.com/elastic/terraform-provider-ec/ec/internal/validators"
+)
+
+const rulesetType = validators.TrafficFilterTypeIP
+
+// expandSources parses and aggregates the sources of the group.
+func expandSources(ctx context.Context, sources types.Set) ([]netip.Prefix, diag.Diagnostics) {
+	var values []string
+	diags := sources.ElementsAs(ctx, &values, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	prefixes := make([]netip.Prefix, 0, len(values))
+	for _, value := range values {
+		prefix, err := parseSource(value)
+		if err != nil {
+			diags.AddError("Invalid traffic filter source", fmt.Sprintf("%q isn't a valid IPv4 or IPv6 address or CIDR block: %s", value, err))
+			continue
+		}
+		prefixes = append(prefixes, prefix)
+	}
+
+	return aggregatePrefixes(prefixes), diags
+}
+
+// expandShards returns the shards of the group from its rulesets.
+func expandShards(ctx context.Context, rulesets types.List) ([]shard, diag.Diagnostics) {
+	if rulesets.IsNull() || rulesets.IsUnknown() {
+		return nil, nil
+	}
+
+	var values []rulesetModelV0
+	diags := rulesets.ElementsAs(ctx, &values, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	shards := make([]shard, 0, len(values))
+	for _, ruleset := range values {
+		s := shard{ID: ruleset.ID.ValueString()}
+		if number, ok := shardNumber(ruleset.Name.ValueString()); ok {
+			s.Number = number
+		}
+		for _, source := range ruleset.Sources {
+			if prefix, err := parseSource(source); err == nil {
+				s.Sources = append(s.Sources, prefix)
+			}
+		}
+		shards = append(shards, s)
+	}
+
+	// Number the rulesets which were renamed outside of Terraform.
+	for i := range shards {
+		if shards[i].Number == 0 {
+			shards[i].Number = nextShardNumber(shards)
+		}
+	}
+
+	return shards, diags
+}
+
+// flattenShards returns the `rulesets` and `ruleset_ids` attributes of the
+// group. The IDs are unknown when some rulesets haven't been created yet.
+func flattenShards(groupName string, shards []shard) (types.List, types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	rulesets := make([]attr.Value, 0, len(shards))
+	ids := make([]attr.Value, 0, len(shards))
+	allCreated := true
+	for _, s := range shards {
+		id := types.StringValue(s.ID)
+		if s.ID == "" {
+			id = types.StringUnknown()
+			allCreated = false
+		}
+
+		sources := make([]attr.Value, 0, len(s.Sources))
+		for _, source := range s.Sources {
+			sources = append(sources, types.StringValue(formatSource(source)))
+		}
+
+		ruleset, d := types.ObjectValue(rulesetElemType().AttrTypes, map[string]attr.Value{
+			"id":      id,
+			"name":    types.StringValue(shardName(groupName, s.Number)),
+			"sources": types.ListValueMust(types.StringType, sources),
+		})
+		diags.Append(d...)
+
+		rulesets = append(rulesets, ruleset)
+		ids = append(ids, id)
+	}
+
+	rulesetList, d := types.ListValue(rulesetElemType(), rulesets)
+	diags.Append(d...)
+
+	if !allCreated {
+		return rulesetList, types.ListUnknown(types.StringType), diags
+	}
+
+	idList, d := types.ListValue(types.StringType, ids)
+	diags.Append(d...)
+
+	return rulesetList, idList, diags
+}
+
+// expandRuleset returns the request to create or update the ruleset of the
+// shard.
+func expandRuleset(state modelV0, s shard) *models.TrafficFilterRulesetRequest {
+	request := models.TrafficFilterRulesetRequest{
+		Name:             new(shardName(state.Name.ValueString(), s.Number)),
+		Type:             new(rulesetType),
+		Region:           new(state.Region.ValueString()),
+		Description:      state.Description.ValueString(),
+		IncludeByDefault: new(state.IncludeByDefault.ValueBool()),
+		Rules:            make([]*models.TrafficFilterRule, 0, len(s.Sources)),
+	}
+
+	for _, source := range s.Sources {
+		request.Rules = append(request.Rules, &models.TrafficFilterRule{
+			Source: formatSource(source),
+		})
+	}
+
+	return &request
+}
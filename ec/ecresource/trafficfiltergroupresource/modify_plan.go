@@ -0,0 +1,100 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package trafficfiltergroupresource
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.ResourceWithModifyPlan = &Resource{}
+
+// ModifyPlan shards the aggregated sources across the rulesets of the group,
+// keeping the sources of the current rulesets where they are.
+func (r *Resource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	// Nothing to do on destroy.
+	if request.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan modelV0
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Name.IsUnknown() || !sourcesKnown(plan.Sources) || plan.MaxRulesPerRuleset.IsUnknown() {
+		response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("rulesets"), types.ListUnknown(rulesetElemType()))...)
+		response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("ruleset_ids"), types.ListUnknown(types.StringType))...)
+		response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("id"), types.StringUnknown())...)
+		return
+	}
+
+	sources, diags := expandSources(ctx, plan.Sources)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var state *modelV0
+	var previous []shard
+	if !request.State.Raw.IsNull() {
+		state = &modelV0{}
+		response.Diagnostics.Append(request.State.Get(ctx, state)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		previous, diags = expandShards(ctx, state.Rulesets)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	shards := assignShards(sources, previous, plan.maxRulesPerRuleset())
+
+	rulesets, rulesetIDs, diags := flattenShards(plan.Name.ValueString(), shards)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("rulesets"), rulesets)...)
+	response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("ruleset_ids"), rulesetIDs)...)
+
+	// The ruleset the ID points to will be deleted, the ID will be re-pointed.
+	if state != nil && !hasShard(shards, state.ID) {
+		response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("id"), types.StringUnknown())...)
+	}
+}
+
+func sourcesKnown(sources types.Set) bool {
+	if sources.IsUnknown() {
+		return false
+	}
+	for _, source := range sources.Elements() {
+		if source.IsUnknown() {
+			return false
+		}
+	}
+	return true
+}
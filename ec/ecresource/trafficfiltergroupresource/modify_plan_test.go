@@ -0,0 +1,151 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package trafficfiltergroupresource
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResource_ModifyPlan(t *testing.T) {
+	ctx := context.Background()
+
+	var schemaResp resource.SchemaResponse
+	(&Resource{}).Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	sch := schemaResp.Schema
+
+	model := func(sources ...string) modelV0 {
+		values := make([]attr.Value, 0, len(sources))
+		for _, source := range sources {
+			values = append(values, types.StringValue(source))
+		}
+		return modelV0{
+			ID:                 types.StringUnknown(),
+			Name:               types.StringValue("office"),
+			Region:             types.StringValue("us-east-1"),
+			Description:        types.StringNull(),
+			IncludeByDefault:   types.BoolValue(false),
+			Sources:            types.SetValueMust(types.StringType, values),
+			MaxRulesPerRuleset: types.Int64Value(2),
+			Rulesets:           types.ListUnknown(rulesetElemType()),
+			RulesetIDs:         types.ListUnknown(types.StringType),
+		}
+	}
+
+	run := func(t *testing.T, plan modelV0, state *modelV0) modelV0 {
+		req := resource.ModifyPlanRequest{
+			Plan:  tfsdk.Plan{Schema: sch},
+			State: tfsdk.State{Schema: sch, Raw: tftypes.NewValue(sch.Type().TerraformType(ctx), nil)},
+		}
+		require.False(t, req.Plan.Set(ctx, plan).HasError())
+		if state != nil {
+			require.False(t, req.State.Set(ctx, state).HasError())
+		}
+
+		resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+		(&Resource{}).ModifyPlan(ctx, req, resp)
+		require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+		var got modelV0
+		require.False(t, resp.Plan.Get(ctx, &got).HasError())
+		return got
+	}
+
+	rulesets := func(t *testing.T, m modelV0) []rulesetModelV0 {
+		var result []rulesetModelV0
+		require.False(t, m.Rulesets.ElementsAs(ctx, &result, false).HasError())
+		return result
+	}
+
+	t.Run("plans new rulesets with unknown IDs", func(t *testing.T) {
+		got := run(t, model("10.0.0.0/25", "10.0.0.128/25", "1.1.1.1", "2.2.2.2"), nil)
+
+		assert.Equal(t, []rulesetModelV0{
+			{ID: types.StringUnknown(), Name: types.StringValue("office-1"), Sources: []string{"1.1.1.1", "2.2.2.2"}},
+			{ID: types.StringUnknown(), Name: types.StringValue("office-2"), Sources: []string{"10.0.0.0/24"}},
+		}, rulesets(t, got))
+		assert.True(t, got.RulesetIDs.IsUnknown())
+	})
+
+	state := model("1.1.1.1", "2.2.2.2", "10.0.0.0/24")
+	state.ID = types.StringValue("ruleset-1")
+	state.Rulesets = types.ListValueMust(rulesetElemType(), []attr.Value{
+		types.ObjectValueMust(rulesetElemType().AttrTypes, map[string]attr.Value{
+			"id":      types.StringValue("ruleset-1"),
+			"name":    types.StringValue("office-1"),
+			"sources": types.ListValueMust(types.StringType, []attr.Value{types.StringValue("1.1.1.1"), types.StringValue("2.2.2.2")}),
+		}),
+		types.ObjectValueMust(rulesetElemType().AttrTypes, map[string]attr.Value{
+			"id":      types.StringValue("ruleset-2"),
+			"name":    types.StringValue("office-2"),
+			"sources": types.ListValueMust(types.StringType, []attr.Value{types.StringValue("10.0.0.0/24")}),
+		}),
+	})
+	state.RulesetIDs = types.ListValueMust(types.StringType, []attr.Value{types.StringValue("ruleset-1"), types.StringValue("ruleset-2")})
+
+	t.Run("keeps the rulesets when the sources are unchanged", func(t *testing.T) {
+		plan := model("2.2.2.2", "1.1.1.1", "10.0.0.0/24")
+		plan.ID = state.ID
+
+		got := run(t, plan, &state)
+		assert.Equal(t, state.Rulesets, got.Rulesets)
+		assert.Equal(t, state.RulesetIDs, got.RulesetIDs)
+	})
+
+	t.Run("only changes the ruleset of the new source", func(t *testing.T) {
+		plan := model("1.1.1.1", "2.2.2.2", "10.0.0.0/24", "3.3.3.3")
+		plan.ID = state.ID
+
+		got := run(t, plan, &state)
+		assert.Equal(t, []rulesetModelV0{
+			{ID: types.StringValue("ruleset-1"), Name: types.StringValue("office-1"), Sources: []string{"1.1.1.1", "2.2.2.2"}},
+			{ID: types.StringValue("ruleset-2"), Name: types.StringValue("office-2"), Sources: []string{"3.3.3.3", "10.0.0.0/24"}},
+		}, rulesets(t, got))
+		assert.Equal(t, state.RulesetIDs, got.RulesetIDs)
+		assert.Equal(t, state.ID, got.ID)
+	})
+
+	t.Run("plans a new ID when the ruleset of the ID is deleted", func(t *testing.T) {
+		plan := model("10.0.0.0/24")
+		plan.ID = state.ID
+
+		got := run(t, plan, &state)
+		assert.Equal(t, []rulesetModelV0{
+			{ID: types.StringValue("ruleset-2"), Name: types.StringValue("office-2"), Sources: []string{"10.0.0.0/24"}},
+		}, rulesets(t, got))
+		assert.True(t, got.ID.IsUnknown())
+	})
+
+	t.Run("leaves the rulesets unknown when the sources are unknown", func(t *testing.T) {
+		plan := model()
+		plan.Sources = types.SetUnknown(types.StringType)
+
+		got := run(t, plan, &state)
+		assert.True(t, got.Rulesets.IsUnknown())
+		assert.True(t, got.RulesetIDs.IsUnknown())
+		assert.True(t, got.ID.IsUnknown())
+	})
+}
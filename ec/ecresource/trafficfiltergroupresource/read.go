@@ -0,0 +1,128 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package trafficfiltergroupresource
+
+import (
+	"context"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/elastic/cloud-sdk-go/pkg/api/deploymentapi/trafficfilterapi"
+	"github.com/elastic/cloud-sdk-go/pkg/models"
+
+	"github.com/elastic/terraform-provider-ec/ec/internal/util"
+)
+
+func (r Resource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	if !resourceReady(r, &response.Diagnostics) {
+		return
+	}
+
+	var newState modelV0
+
+	diags := request.State.Get(ctx, &newState)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	found, diags := r.read(ctx, &newState)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	// Finally, set the state
+	response.Diagnostics.Append(response.State.Set(ctx, newState)...)
+}
+
+// read refreshes the rulesets of the group. Rulesets which no longer exist are
+// dropped, and the group is gone when none of them is left.
+func (r Resource) read(ctx context.Context, state *modelV0) (found bool, diags diag.Diagnostics) {
+	previous, diags := expandShards(ctx, state.Rulesets)
+	if diags.HasError() {
+		return true, diags
+	}
+
+	shards := make([]shard, 0, len(previous))
+	for _, s := range previous {
+		res, err := trafficfilterapi.Get(trafficfilterapi.GetParams{
+			API: r.client, ID: s.ID, IncludeAssociations: false,
+		})
+		if err != nil {
+			if util.TrafficFilterNotFound(err) {
+				continue
+			}
+			diags.AddError(err.Error(), err.Error())
+			return true, diags
+		}
+
+		if len(shards) == 0 {
+			modelToState(res, state)
+		}
+		shards = append(shards, rulesetToShard(res, s))
+	}
+
+	if len(shards) == 0 {
+		return false, diags
+	}
+
+	diags.Append(setShards(state, shards)...)
+	return true, diags
+}
+
+// modelToState sets the group attributes from one of its rulesets.
+func modelToState(res *models.TrafficFilterRulesetInfo, state *modelV0) {
+	if res.Region != nil {
+		state.Region = types.StringValue(*res.Region)
+	}
+	if res.IncludeByDefault != nil {
+		state.IncludeByDefault = types.BoolValue(*res.IncludeByDefault)
+	}
+	if res.Description != "" {
+		state.Description = types.StringValue(res.Description)
+	} else {
+		state.Description = types.StringNull()
+	}
+}
+
+// rulesetToShard returns the shard held by the ruleset.
+func rulesetToShard(res *models.TrafficFilterRulesetInfo, previous shard) shard {
+	s := shard{ID: previous.ID, Number: previous.Number}
+	if res.Name != nil {
+		if number, ok := shardNumber(*res.Name); ok {
+			s.Number = number
+		}
+	}
+
+	for _, rule := range res.Rules {
+		if prefix, err := parseSource(rule.Source); err == nil {
+			s.Sources = append(s.Sources, prefix)
+		}
+	}
+	slices.SortFunc(s.Sources, comparePrefixes)
+
+	return s
+}
@@ -0,0 +1,183 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package trafficfiltergroupresource
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/elastic/cloud-sdk-go/pkg/api"
+
+	"github.com/elastic/terraform-provider-ec/ec/internal"
+	"github.com/elastic/terraform-provider-ec/ec/internal/planmodifiers"
+)
+
+// defaultMaxRulesPerRuleset is the number of rules per ruleset used when
+// `max_rules_per_ruleset` isn't set.
+const defaultMaxRulesPerRuleset = 100
+
+var _ resource.Resource = &Resource{}
+var _ resource.ResourceWithConfigure = &Resource{}
+
+func (r *Resource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `Provides an Elastic Cloud traffic filter group resource, which manages a set of IP sources too large for a single traffic filter ruleset. Adjacent CIDR blocks are aggregated, and the resulting sources are spread across as many ` + "`ip`" + ` rulesets as needed.
+
+  ~> **Note on shard stability** Sources keep their ruleset across updates, so adding or removing a few sources only updates the rulesets holding them. Rulesets left empty are deleted, along with their deployment associations.`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier of this resource, the ID of the first ruleset created for the group. When that ruleset is deleted, e.g. because its sources were removed, the ID moves to the first remaining ruleset.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the group. The rulesets are named after the group, with a numeric suffix, e.g. `name-1`.",
+				Required:    true,
+			},
+			"region": schema.StringAttribute{
+				Description: "Filter region, the rulesets can only be attached to deployments in the specific region",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the rulesets",
+				Optional:    true,
+			},
+			"include_by_default": schema.BoolAttribute{
+				Description: "Indicates that the rulesets should be automatically included in new deployments (Defaults to false)",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					planmodifiers.BoolDefaultValue(false),
+				},
+			},
+			"sources": schema.SetAttribute{
+				Description: "Set of IPv4 or IPv6 addresses and CIDR blocks allowed by the group.",
+				ElementType: types.StringType,
+				Required:    true,
+				Validators:  []validator.Set{setvalidator.SizeAtLeast(1)},
+			},
+			"max_rules_per_ruleset": schema.Int64Attribute{
+				Description: "Maximum number of rules of each ruleset (Defaults to 100)",
+				Optional:    true,
+				Validators:  []validator.Int64{int64validator.AtLeast(1)},
+			},
+			"rulesets": schema.ListNestedAttribute{
+				Description: "Rulesets of the group, with the aggregated sources they allow.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "ID of the ruleset",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Name of the ruleset",
+							Computed:    true,
+						},
+						"sources": schema.ListAttribute{
+							Description: "Sources of the rules of the ruleset",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"ruleset_ids": schema.ListAttribute{
+				Description: "IDs of the rulesets of the group, to be used in `ec_deployment.traffic_filter`.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func rulesetElemType() types.ObjectType {
+	return types.ObjectType{AttrTypes: map[string]attr.Type{
+		"id":      types.StringType,
+		"name":    types.StringType,
+		"sources": types.ListType{ElemType: types.StringType},
+	}}
+}
+
+type Resource struct {
+	client *api.API
+}
+
+func resourceReady(r Resource, dg *diag.Diagnostics) bool {
+	if r.client == nil {
+		dg.AddError(
+			"Unconfigured API Client",
+			"Expected configured API client. Please report this issue to the provider developers.",
+		)
+
+		return false
+	}
+	return true
+}
+
+func (r *Resource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	clients, diags := internal.ConvertProviderData(request.ProviderData)
+	response.Diagnostics.Append(diags...)
+	r.client = clients.Stateful
+}
+
+func (r *Resource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_deployment_traffic_filter_group"
+}
+
+type modelV0 struct {
+	ID                 types.String `tfsdk:"id"`
+	Name               types.String `tfsdk:"name"`
+	Region             types.String `tfsdk:"region"`
+	Description        types.String `tfsdk:"description"`
+	IncludeByDefault   types.Bool   `tfsdk:"include_by_default"`
+	Sources            types.Set    `tfsdk:"sources"`
+	MaxRulesPerRuleset types.Int64  `tfsdk:"max_rules_per_ruleset"`
+	Rulesets           types.List   `tfsdk:"rulesets"` //< rulesetModelV0
+	RulesetIDs         types.List   `tfsdk:"ruleset_ids"`
+}
+
+type rulesetModelV0 struct {
+	ID      types.String `tfsdk:"id"`
+	Name    types.String `tfsdk:"name"`
+	Sources []string     `tfsdk:"sources"`
+}
+
+// maxRulesPerRuleset returns the maximum number of rules of each ruleset of
+// the group.
+func (m modelV0) maxRulesPerRuleset() int {
+	if m.MaxRulesPerRuleset.IsNull() || m.MaxRulesPerRuleset.IsUnknown() {
+		return defaultMaxRulesPerRuleset
+	}
+	return int(m.MaxRulesPerRuleset.ValueInt64())
+}
@@ -0,0 +1,222 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package trafficfiltergroupresource
+
+import (
+	"fmt"
+	"net/netip"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// shard holds the sources of one of the rulesets of the group.
+type shard struct {
+	// ID of the ruleset, empty when the ruleset doesn't exist yet.
+	ID string
+	// Number of the shard, used to name the ruleset. It's kept for the
+	// lifetime of the ruleset so that renumbering doesn't touch every ruleset.
+	Number  int
+	Sources []netip.Prefix
+}
+
+// parseSource parses an IPv4 or IPv6 address or CIDR block. Host bits are
+// cleared, since the ruleset allows the same addresses either way.
+func parseSource(source string) (netip.Prefix, error) {
+	if !strings.Contains(source, "/") {
+		addr, err := netip.ParseAddr(source)
+		if err != nil {
+			return netip.Prefix{}, err
+		}
+		return netip.PrefixFrom(addr, addr.BitLen()), nil
+	}
+
+	prefix, err := netip.ParsePrefix(source)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+	return prefix.Masked(), nil
+}
+
+// formatSource returns the source of a rule, single addresses are formatted
+// without their prefix length.
+func formatSource(prefix netip.Prefix) string {
+	if prefix.IsSingleIP() {
+		return prefix.Addr().String()
+	}
+	return prefix.String()
+}
+
+func comparePrefixes(a, b netip.Prefix) int {
+	if c := a.Addr().Compare(b.Addr()); c != 0 {
+		return c
+	}
+	return a.Bits() - b.Bits()
+}
+
+// aggregatePrefixes returns the smallest sorted set of prefixes allowing
+// exactly the same addresses as prefixes: duplicates and prefixes contained in
+// another one are dropped, and adjacent prefixes are merged.
+func aggregatePrefixes(prefixes []netip.Prefix) []netip.Prefix {
+	result := slices.Clone(prefixes)
+	for {
+		slices.SortFunc(result, comparePrefixes)
+		result = slices.Compact(result)
+
+		merged := make([]netip.Prefix, 0, len(result))
+		changed := false
+		for i := 0; i < len(result); i++ {
+			current := result[i]
+
+			// Drop the prefixes contained in the previous one.
+			if len(merged) > 0 {
+				last := merged[len(merged)-1]
+				if last.Bits() <= current.Bits() && last.Contains(current.Addr()) {
+					changed = true
+					continue
+				}
+			}
+
+			// Merge the two halves of a prefix.
+			if i+1 < len(result) && current.Bits() > 0 {
+				next := result[i+1]
+				parent := netip.PrefixFrom(current.Addr(), current.Bits()-1).Masked()
+				if next.Bits() == current.Bits() && parent.Addr() == current.Addr() && parent.Contains(next.Addr()) {
+					merged = append(merged, parent)
+					changed = true
+					i++
+					continue
+				}
+			}
+
+			merged = append(merged, current)
+		}
+
+		result = merged
+		if !changed {
+			return result
+		}
+	}
+}
+
+// assignShards distributes sources across shards of at most maxRules rules.
+//
+// The assignment is deterministic and stable: the sources of previous stay in
+// their shard, an aggregated source goes to the shard of the previous sources
+// it replaces, and new sources go to the least loaded shard with room left
+// before a new shard is added. Small edits of the sources therefore only
+// touch the shards holding the edited sources. Shards left empty are dropped.
+func assignShards(sources []netip.Prefix, previous []shard, maxRules int) []shard {
+	shards := make([]shard, len(previous))
+	previousShard := make(map[netip.Prefix]int)
+	for i, s := range previous {
+		shards[i] = shard{ID: s.ID, Number: s.Number}
+		for _, source := range s.Sources {
+			previousShard[source] = i
+		}
+	}
+
+	hasRoom := func(i int) bool {
+		return len(shards[i].Sources) < maxRules
+	}
+
+	// relatedShard returns the shard of the previous sources overlapping
+	// source, e.g. the ones merged into it, or -1.
+	relatedShard := func(source netip.Prefix) int {
+		related := -1
+		var relatedSource netip.Prefix
+		for previousSource, i := range previousShard {
+			if !source.Overlaps(previousSource) {
+				continue
+			}
+			if related < 0 || comparePrefixes(previousSource, relatedSource) < 0 {
+				related, relatedSource = i, previousSource
+			}
+		}
+		return related
+	}
+
+	var pending []netip.Prefix
+	for _, source := range sources {
+		if i, ok := previousShard[source]; ok && hasRoom(i) {
+			shards[i].Sources = append(shards[i].Sources, source)
+			continue
+		}
+		pending = append(pending, source)
+	}
+
+	var unassigned []netip.Prefix
+	for _, source := range pending {
+		if i := relatedShard(source); i >= 0 && hasRoom(i) {
+			shards[i].Sources = append(shards[i].Sources, source)
+			continue
+		}
+		unassigned = append(unassigned, source)
+	}
+
+	for _, source := range unassigned {
+		target := -1
+		for i := range shards {
+			if hasRoom(i) && (target < 0 || len(shards[i].Sources) < len(shards[target].Sources)) {
+				target = i
+			}
+		}
+
+		if target < 0 {
+			shards = append(shards, shard{Number: nextShardNumber(shards)})
+			target = len(shards) - 1
+		}
+
+		shards[target].Sources = append(shards[target].Sources, source)
+	}
+
+	result := make([]shard, 0, len(shards))
+	for _, s := range shards {
+		if len(s.Sources) == 0 {
+			continue
+		}
+		slices.SortFunc(s.Sources, comparePrefixes)
+		result = append(result, s)
+	}
+	return result
+}
+
+// nextShardNumber returns the lowest shard number which isn't used yet.
+func nextShardNumber(shards []shard) int {
+	for number := 1; ; number++ {
+		if !slices.ContainsFunc(shards, func(s shard) bool { return s.Number == number }) {
+			return number
+		}
+	}
+}
+
+// shardName returns the name of the ruleset of the shard.
+func shardName(groupName string, number int) string {
+	return fmt.Sprintf("%s-%d", groupName, number)
+}
+
+// shardNumber returns the number of the shard from the name of its ruleset,
+// regardless of the group name so that renaming the group keeps the numbers.
+func shardNumber(rulesetName string) (int, bool) {
+	i := strings.LastIndex(rulesetName, "-")
+	if i < 0 {
+		return 0, false
+	}
+	number, err := strconv.Atoi(rulesetName[i+1:])
+	return number, err == nil && number > 0
+}
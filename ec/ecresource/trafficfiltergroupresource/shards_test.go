@@ -0,0 +1,171 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package trafficfiltergroupresource
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func prefixes(t *testing.T, sources ...string) []netip.Prefix {
+	t.Helper()
+	result := make([]netip.Prefix, 0, len(sources))
+	for _, source := range sources {
+		prefix, err := parseSource(source)
+		require.NoError(t, err)
+		result = append(result, prefix)
+	}
+	return result
+}
+
+func sources(prefixes []netip.Prefix) []string {
+	result := make([]string, 0, len(prefixes))
+	for _, prefix := range prefixes {
+		result = append(result, formatSource(prefix))
+	}
+	return result
+}
+
+func Test_aggregatePrefixes(t *testing.T) {
+	tests := []struct {
+		name    string
+		sources []string
+		want    []string
+	}{
+		{
+			name:    "keeps unrelated sources sorted",
+			sources: []string{"10.0.0.0/24", "1.1.1.1", "2001:db8::/32"},
+			want:    []string{"1.1.1.1", "10.0.0.0/24", "2001:db8::/32"},
+		},
+		{
+			name:    "drops duplicates and contained sources",
+			sources: []string{"10.0.0.0/16", "10.0.1.0/24", "10.0.0.1", "10.0.0.0/16"},
+			want:    []string{"10.0.0.0/16"},
+		},
+		{
+			name:    "clears host bits",
+			sources: []string{"8.8.8.8/24", "1.1.1.1/32"},
+			want:    []string{"1.1.1.1", "8.8.8.0/24"},
+		},
+		{
+			name:    "merges adjacent blocks repeatedly",
+			sources: []string{"10.0.0.0/26", "10.0.0.64/26", "10.0.0.128/25", "10.0.1.0", "10.0.1.1"},
+			want:    []string{"10.0.0.0/24", "10.0.1.0/31"},
+		},
+		{
+			name:    "doesn't merge blocks which aren't halves of the same block",
+			sources: []string{"10.0.1.0/24", "10.0.2.0/24"},
+			want:    []string{"10.0.1.0/24", "10.0.2.0/24"},
+		},
+		{
+			name:    "merges IPv6 blocks",
+			sources: []string{"2001:db8::/33", "2001:db8:8000::/33"},
+			want:    []string{"2001:db8::/32"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, sources(aggregatePrefixes(prefixes(t, tt.sources...))))
+		})
+	}
+}
+
+func Test_assignShards(t *testing.T) {
+	t.Run("fills new shards up to the maximum", func(t *testing.T) {
+		got := assignShards(prefixes(t, "1.1.1.1", "2.2.2.2", "3.3.3.3", "4.4.4.4", "5.5.5.5"), nil, 2)
+
+		require.Len(t, got, 3)
+		assert.Equal(t, 1, got[0].Number)
+		assert.Equal(t, 2, got[1].Number)
+		assert.Equal(t, 3, got[2].Number)
+		for _, s := range got {
+			assert.LessOrEqual(t, len(s.Sources), 2)
+		}
+	})
+
+	t.Run("is deterministic", func(t *testing.T) {
+		input := prefixes(t, "1.1.1.1", "2.2.2.2", "3.3.3.3", "4.4.4.4", "5.5.5.5")
+		assert.Equal(t, assignShards(input, nil, 2), assignShards(input, nil, 2))
+	})
+
+	previous := []shard{
+		{ID: "a", Number: 1, Sources: prefixes(t, "1.1.1.1", "2.2.2.2", "3.3.3.3")},
+		{ID: "b", Number: 2, Sources: prefixes(t, "4.4.4.4", "10.0.0.0/25")},
+	}
+
+	t.Run("keeps the shards unchanged", func(t *testing.T) {
+		got := assignShards(prefixes(t, "1.1.1.1", "2.2.2.2", "3.3.3.3", "4.4.4.4", "10.0.0.0/25"), previous, 3)
+		assert.Equal(t, previous, got)
+	})
+
+	t.Run("only touches the shard of a removed source", func(t *testing.T) {
+		got := assignShards(prefixes(t, "1.1.1.1", "3.3.3.3", "4.4.4.4", "10.0.0.0/25"), previous, 3)
+		assert.Equal(t, previous[1], got[1])
+		assert.Equal(t, []string{"1.1.1.1", "3.3.3.3"}, sources(got[0].Sources))
+	})
+
+	t.Run("adds a new source to the least loaded shard", func(t *testing.T) {
+		got := assignShards(prefixes(t, "1.1.1.1", "2.2.2.2", "3.3.3.3", "4.4.4.4", "9.9.9.9", "10.0.0.0/25"), previous, 3)
+		assert.Equal(t, previous[0], got[0])
+		assert.Equal(t, []string{"4.4.4.4", "9.9.9.9", "10.0.0.0/25"}, sources(got[1].Sources))
+	})
+
+	t.Run("keeps an aggregated source in the shard of the sources it replaces", func(t *testing.T) {
+		got := assignShards(prefixes(t, "1.1.1.1", "2.2.2.2", "3.3.3.3", "4.4.4.4", "10.0.0.0/24"), previous, 3)
+		assert.Equal(t, previous[0], got[0])
+		assert.Equal(t, []string{"4.4.4.4", "10.0.0.0/24"}, sources(got[1].Sources))
+	})
+
+	t.Run("adds a shard when the others are full", func(t *testing.T) {
+		got := assignShards(prefixes(t, "1.1.1.1", "2.2.2.2", "3.3.3.3", "4.4.4.4", "8.8.8.8", "9.9.9.9", "10.0.0.0/25"), previous, 3)
+		require.Len(t, got, 3)
+		assert.Equal(t, previous[0], got[0])
+		assert.Equal(t, shard{Number: 3, Sources: prefixes(t, "9.9.9.9")}, got[2])
+	})
+
+	t.Run("drops empty shards and reuses their number", func(t *testing.T) {
+		got := assignShards(prefixes(t, "1.1.1.1", "2.2.2.2", "3.3.3.3"), previous, 3)
+		assert.Equal(t, []shard{previous[0]}, got)
+
+		got = assignShards(prefixes(t, "4.4.4.4", "10.0.0.0/25"), previous, 3)
+		assert.Equal(t, []shard{previous[1]}, got)
+	})
+
+	t.Run("moves sources out of shards above the maximum", func(t *testing.T) {
+		got := assignShards(prefixes(t, "1.1.1.1", "2.2.2.2", "3.3.3.3", "4.4.4.4", "10.0.0.0/25"), previous, 2)
+		require.Len(t, got, 3)
+		assert.Equal(t, []string{"1.1.1.1", "2.2.2.2"}, sources(got[0].Sources))
+		assert.Equal(t, previous[1], got[1])
+		assert.Equal(t, shard{Number: 3, Sources: prefixes(t, "3.3.3.3")}, got[2])
+	})
+}
+
+func Test_shardNumber(t *testing.T) {
+	number, ok := shardNumber(shardName("office-networks", 12))
+	assert.True(t, ok)
+	assert.Equal(t, 12, number)
+
+	_, ok = shardNumber("office-networks")
+	assert.False(t, ok)
+
+	_, ok = shardNumber("office-0")
+	assert.False(t, ok)
+}
@@ -0,0 +1,69 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package trafficfiltergroupresource
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func (r Resource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	if !resourceReady(r, &response.Diagnostics) {
+		return
+	}
+
+	var newState, oldState modelV0
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &newState)...)
+	response.Diagnostics.Append(request.State.Get(ctx, &oldState)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	previous, diags := expandShards(ctx, oldState.Rulesets)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	groupChanged := !newState.Name.Equal(oldState.Name) ||
+		!newState.Description.Equal(oldState.Description) ||
+		!newState.IncludeByDefault.Equal(oldState.IncludeByDefault)
+
+	// The ID is unknown when its ruleset is planned to be deleted, it's
+	// re-pointed once the ruleset is gone.
+	if newState.ID.IsUnknown() {
+		newState.ID = oldState.ID
+	}
+
+	shards, diags := r.apply(ctx, newState, previous, groupChanged)
+	response.Diagnostics.Append(diags...)
+
+	// On failure, the state records the rulesets as they are, so that the
+	// next plan picks up from there. The group attributes are kept as they
+	// were since some rulesets may not have been updated yet, which updates
+	// all of them again on the next apply.
+	if diags.HasError() {
+		newState.Name = oldState.Name
+		newState.Description = oldState.Description
+		newState.IncludeByDefault = oldState.IncludeByDefault
+	}
+	response.Diagnostics.Append(setShards(&newState, shards)...)
+	response.Diagnostics.Append(response.State.Set(ctx, newState)...)
+}
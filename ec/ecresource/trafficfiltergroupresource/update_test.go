@@ -0,0 +1,132 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package trafficfiltergroupresource
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/cloud-sdk-go/pkg/api"
+	"github.com/elastic/cloud-sdk-go/pkg/api/mock"
+)
+
+func TestResource_Update(t *testing.T) {
+	ctx := context.Background()
+
+	var schemaResp resource.SchemaResponse
+	(&Resource{}).Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	sch := schemaResp.Schema
+
+	ruleset := func(id, name string, sources ...string) attr.Value {
+		values := make([]attr.Value, 0, len(sources))
+		for _, source := range sources {
+			values = append(values, types.StringValue(source))
+		}
+		return types.ObjectValueMust(rulesetElemType().AttrTypes, map[string]attr.Value{
+			"id":      types.StringValue(id),
+			"name":    types.StringValue(name),
+			"sources": types.ListValueMust(types.StringType, values),
+		})
+	}
+
+	state := modelV0{
+		ID:               types.StringValue("ruleset-1"),
+		Name:             types.StringValue("office"),
+		Region:           types.StringValue("us-east-1"),
+		Description:      types.StringNull(),
+		IncludeByDefault: types.BoolValue(false),
+		Sources: types.SetValueMust(types.StringType, []attr.Value{
+			types.StringValue("1.1.1.1"), types.StringValue("2.2.2.2"), types.StringValue("10.0.0.0/24"),
+		}),
+		MaxRulesPerRuleset: types.Int64Value(2),
+		Rulesets: types.ListValueMust(rulesetElemType(), []attr.Value{
+			ruleset("ruleset-1", "office-1", "1.1.1.1", "2.2.2.2"),
+			ruleset("ruleset-2", "office-2", "10.0.0.0/24"),
+		}),
+		RulesetIDs: types.ListValueMust(types.StringType, []attr.Value{
+			types.StringValue("ruleset-1"), types.StringValue("ruleset-2"),
+		}),
+	}
+
+	run := func(t *testing.T, client *api.API, plan modelV0) (modelV0, *resource.UpdateResponse) {
+		req := resource.UpdateRequest{
+			Plan:  tfsdk.Plan{Schema: sch},
+			State: tfsdk.State{Schema: sch},
+		}
+		require.False(t, req.Plan.Set(ctx, plan).HasError())
+		require.False(t, req.State.Set(ctx, state).HasError())
+
+		resp := &resource.UpdateResponse{
+			State: tfsdk.State{Schema: sch, Raw: tftypes.NewValue(sch.Type().TerraformType(ctx), nil)},
+		}
+		(&Resource{client: client}).Update(ctx, req, resp)
+
+		var got modelV0
+		require.False(t, resp.State.Get(ctx, &got).HasError())
+		return got, resp
+	}
+
+	t.Run("keeps the group attributes when a ruleset fails to update", func(t *testing.T) {
+		plan := state
+		plan.Name = types.StringValue("branch")
+		plan.Description = types.StringValue("Branch office")
+		plan.IncludeByDefault = types.BoolValue(true)
+		plan.Rulesets = types.ListValueMust(rulesetElemType(), []attr.Value{
+			ruleset("ruleset-1", "branch-1", "1.1.1.1", "2.2.2.2"),
+			ruleset("ruleset-2", "branch-2", "10.0.0.0/24"),
+		})
+
+		client := api.NewMock(
+			mock.New200Response(mock.NewStringBody(`{"id": "ruleset-1"}`)),
+			mock.NewErrorResponse(500, mock.APIError{
+				Code: "some", Message: "failed updating ruleset",
+			}),
+		)
+
+		got, resp := run(t, client, plan)
+		require.True(t, resp.Diagnostics.HasError())
+		assert.Equal(t, state.Name, got.Name)
+		assert.Equal(t, state.Description, got.Description)
+		assert.Equal(t, state.IncludeByDefault, got.IncludeByDefault)
+		assert.Equal(t, state.Rulesets, got.Rulesets)
+	})
+
+	t.Run("re-points the ID when its ruleset is deleted", func(t *testing.T) {
+		plan := state
+		plan.ID = types.StringUnknown()
+		plan.Sources = types.SetValueMust(types.StringType, []attr.Value{types.StringValue("10.0.0.0/24")})
+		plan.Rulesets = types.ListValueMust(rulesetElemType(), []attr.Value{
+			ruleset("ruleset-2", "office-2", "10.0.0.0/24"),
+		})
+
+		client := api.NewMock(mock.New200Response(mock.NewStringBody(`{}`)))
+
+		got, resp := run(t, client, plan)
+		require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+		assert.Equal(t, types.StringValue("ruleset-2"), got.ID)
+		assert.Equal(t, plan.Rulesets, got.Rulesets)
+	})
+}
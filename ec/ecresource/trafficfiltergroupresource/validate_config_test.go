@@ -0,0 +1,97 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package trafficfiltergroupresource
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResource_ValidateConfig(t *testing.T) {
+	ctx := context.Background()
+
+	var schemaResp resource.SchemaResponse
+	(&Resource{}).Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	sch := schemaResp.Schema
+
+	sourcePath := func(source string) path.Path {
+		return path.Root("sources").AtSetValue(types.StringValue(source))
+	}
+
+	tests := []struct {
+		name          string
+		sources       []string
+		expectedDiags diag.Diagnostics
+	}{
+		{
+			name:    "overlapping sources are valid",
+			sources: []string{"10.0.0.0/24", "10.0.0.0/25", "10.0.0.1", "2001:db8::/32"},
+		},
+		{
+			name:    "invalid sources are reported on the source",
+			sources: []string{"10.0.0.0/24", "not-an-ip"},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					sourcePath("not-an-ip"),
+					"Invalid Traffic Filter Source",
+					`"not-an-ip" is not a valid IPv4 or IPv6 address or CIDR block: ParseAddr("not-an-ip"): unable to parse IP`,
+				),
+			},
+		},
+		{
+			name:    "sources equal after masking are valid",
+			sources: []string{"10.0.0.1", "10.0.0.1/32", "10.0.0.5/24", "10.0.0.0/24"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values := make([]attr.Value, 0, len(tt.sources))
+			for _, source := range tt.sources {
+				values = append(values, types.StringValue(source))
+			}
+
+			state := tfsdk.State{Schema: sch}
+			require.False(t, state.Set(ctx, modelV0{
+				ID:                 types.StringNull(),
+				Name:               types.StringValue("office"),
+				Region:             types.StringValue("us-east-1"),
+				Description:        types.StringNull(),
+				IncludeByDefault:   types.BoolNull(),
+				Sources:            types.SetValueMust(types.StringType, values),
+				MaxRulesPerRuleset: types.Int64Null(),
+				Rulesets:           types.ListNull(rulesetElemType()),
+				RulesetIDs:         types.ListNull(types.StringType),
+			}).HasError())
+
+			resp := &resource.ValidateConfigResponse{}
+			(&Resource{}).ValidateConfig(ctx, resource.ValidateConfigRequest{
+				Config: tfsdk.Config{Schema: sch, Raw: state.Raw},
+			}, resp)
+			require.Equal(t, tt.expectedDiags, resp.Diagnostics)
+		})
+	}
+}
@@ -36,6 +36,7 @@ import (
 
 	"github.com/elastic/terraform-provider-ec/ec/internal"
 	"github.com/elastic/terraform-provider-ec/ec/internal/planmodifiers"
+	"github.com/elastic/terraform-provider-ec/ec/internal/validators"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces
@@ -95,7 +96,7 @@ func trafficFilterRuleSchema() schema.Block {
 		NestedObject: schema.NestedBlockObject{
 			Attributes: map[string]schema.Attribute{
 				"source": schema.StringAttribute{
-					Description: "Traffic filter source: IP address, CIDR mask, or VPC endpoint ID, **only required** when the type is not `azure_private_endpoint`",
+					Description: "Traffic filter source: IPv4 or IPv6 address or CIDR block for `ip` rulesets, VPC endpoint ID for `vpce` rulesets, or Private Service Connect connection ID for `gcp_private_service_connect_endpoint` rulesets. Not applicable to `azure_private_endpoint` and `remote_cluster` rulesets.",
 					Optional:    true,
 				},
 				"description": schema.StringAttribute{
@@ -189,45 +190,19 @@ func (r *Resource) ValidateConfig(ctx context.Context, request resource.Validate
 		return
 	}
 
+	validatorRules := make([]validators.TrafficFilterRule, 0, len(rules))
 	for i, rule := range rules {
-		rulePath := path.Root("rule").AtSetValue(config.Rule.Elements()[i])
-
-		// Validate remote_cluster fields
-		hasRemoteClusterID := !rule.RemoteClusterId.IsNull() && !rule.RemoteClusterId.IsUnknown()
-		hasRemoteClusterOrgID := !rule.RemoteClusterOrgId.IsNull() && !rule.RemoteClusterOrgId.IsUnknown()
-
-		if filterType != "remote_cluster" && (hasRemoteClusterID || hasRemoteClusterOrgID) {
-			response.Diagnostics.AddAttributeError(
-				rulePath,
-				"Invalid Rule Configuration",
-				"The 'remote_cluster_id' and 'remote_cluster_org_id' attributes can only be specified when 'type' is set to 'remote_cluster'.",
-			)
-		}
-
-		// Only validate required fields if values are known (not unknown due to variables)
-		// Unknown values will be validated at apply time when they become known
-		if filterType == "remote_cluster" && !rule.RemoteClusterId.IsUnknown() && !rule.RemoteClusterOrgId.IsUnknown() {
-			if rule.RemoteClusterId.IsNull() || rule.RemoteClusterOrgId.IsNull() {
-				response.Diagnostics.AddAttributeError(
-					rulePath,
-					"Missing Required Attributes",
-					"Both 'remote_cluster_id' and 'remote_cluster_org_id' are required when 'type' is set to 'remote_cluster'.",
-				)
-			}
-		}
-
-		// Validate azure fields
-		hasAzureEndpointName := !rule.AzureEndpointName.IsNull() && !rule.AzureEndpointName.IsUnknown()
-		hasAzureEndpointGUID := !rule.AzureEndpointGUID.IsNull() && !rule.AzureEndpointGUID.IsUnknown()
-
-		if filterType != "azure_private_endpoint" && (hasAzureEndpointName || hasAzureEndpointGUID) {
-			response.Diagnostics.AddAttributeError(
-				rulePath,
-				"Invalid Rule Configuration",
-				"The 'azure_endpoint_name' and 'azure_endpoint_guid' attributes can only be specified when 'type' is set to 'azure_private_endpoint'.",
-			)
-		}
+		validatorRules = append(validatorRules, validators.TrafficFilterRule{
+			Path:               path.Root("rule").AtSetValue(config.Rule.Elements()[i]),
+			Source:             rule.Source,
+			AzureEndpointName:  rule.AzureEndpointName,
+			AzureEndpointGUID:  rule.AzureEndpointGUID,
+			RemoteClusterID:    rule.RemoteClusterId,
+			RemoteClusterOrgID: rule.RemoteClusterOrgId,
+		})
 	}
+
+	response.Diagnostics.Append(validators.ValidateTrafficFilterRules(filterType, validatorRules)...)
 }
 
 func (r *Resource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
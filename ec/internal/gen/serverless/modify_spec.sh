@@ -46,4 +46,48 @@ jq '
   }]
 ' /tmp/with-traffic-filters.json >/tmp/with-linked.json
 
-mv /tmp/with-linked.json ./spec-mod.json
+# Add a timeouts block to all project resources, along with the interval between
+# project status polls. The durations are parsed by the hand-written resource
+# code, which falls back to its defaults when unset.
+jq '(.resources[] | select(.name | endswith("_project")) | .schema.blocks) += [{
+  "name": "timeouts",
+  "single_nested": {
+    "description": "Timeouts for the operations on the project.",
+    "attributes": [
+      {
+        "name": "create",
+        "string": {
+          "computed_optional_required": "optional",
+          "description": "How long to wait for the project to be created and initialised, e.g. \"45m\". Defaults to 30 minutes.",
+          "validators": [{ "custom": { "imports": [{ "path": "github.com/elastic/terraform-provider-ec/ec/internal/validators" }], "schema_definition": "validators.PositiveDuration()" } }]
+        }
+      },
+      {
+        "name": "update",
+        "string": {
+          "computed_optional_required": "optional",
+          "description": "How long to wait for the project to be updated, e.g. \"45m\". Defaults to 30 minutes.",
+          "validators": [{ "custom": { "imports": [{ "path": "github.com/elastic/terraform-provider-ec/ec/internal/validators" }], "schema_definition": "validators.PositiveDuration()" } }]
+        }
+      },
+      {
+        "name": "delete",
+        "string": {
+          "computed_optional_required": "optional",
+          "description": "How long to wait for the project to be deleted, e.g. \"45m\". Defaults to 30 minutes.",
+          "validators": [{ "custom": { "imports": [{ "path": "github.com/elastic/terraform-provider-ec/ec/internal/validators" }], "schema_definition": "validators.PositiveDuration()" } }]
+        }
+      },
+      {
+        "name": "poll_interval",
+        "string": {
+          "computed_optional_required": "optional",
+          "description": "How often to poll the project status while waiting for an operation to complete, e.g. \"10s\". Defaults to 5 seconds.",
+          "validators": [{ "custom": { "imports": [{ "path": "github.com/elastic/terraform-provider-ec/ec/internal/validators" }], "schema_definition": "validators.PositiveDuration()" } }]
+        }
+      }
+    ]
+  }
+}]' /tmp/with-linked.json >/tmp/with-timeouts.json
+
+mv /tmp/with-timeouts.json ./spec-mod.json
@@ -5,6 +5,7 @@ package resource_elasticsearch_project
 import (
 	"context"
 	"fmt"
+	"github.com/elastic/terraform-provider-ec/ec/internal/validators"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -295,6 +296,51 @@ func ElasticsearchProjectResourceSchema(ctx context.Context) schema.Schema {
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"create": schema.StringAttribute{
+						Optional:            true,
+						Description:         "How long to wait for the project to be created and initialised, e.g. \"45m\". Defaults to 30 minutes.",
+						MarkdownDescription: "How long to wait for the project to be created and initialised, e.g. \"45m\". Defaults to 30 minutes.",
+						Validators: []validator.String{
+							validators.PositiveDuration(),
+						},
+					},
+					"delete": schema.StringAttribute{
+						Optional:            true,
+						Description:         "How long to wait for the project to be deleted, e.g. \"45m\". Defaults to 30 minutes.",
+						MarkdownDescription: "How long to wait for the project to be deleted, e.g. \"45m\". Defaults to 30 minutes.",
+						Validators: []validator.String{
+							validators.PositiveDuration(),
+						},
+					},
+					"poll_interval": schema.StringAttribute{
+						Optional:            true,
+						Description:         "How often to poll the project status while waiting for an operation to complete, e.g. \"10s\". Defaults to 5 seconds.",
+						MarkdownDescription: "How often to poll the project status while waiting for an operation to complete, e.g. \"10s\". Defaults to 5 seconds.",
+						Validators: []validator.String{
+							validators.PositiveDuration(),
+						},
+					},
+					"update": schema.StringAttribute{
+						Optional:            true,
+						Description:         "How long to wait for the project to be updated, e.g. \"45m\". Defaults to 30 minutes.",
+						MarkdownDescription: "How long to wait for the project to be updated, e.g. \"45m\". Defaults to 30 minutes.",
+						Validators: []validator.String{
+							validators.PositiveDuration(),
+						},
+					},
+				},
+				CustomType: TimeoutsType{
+					ObjectType: types.ObjectType{
+						AttrTypes: TimeoutsValue{}.AttributeTypes(ctx),
+					},
+				},
+				Description:         "Timeouts for the operations on the project.",
+				MarkdownDescription: "Timeouts for the operations on the project.",
+			},
+		},
 	}
 }
 
@@ -313,6 +359,7 @@ type ElasticsearchProjectModel struct {
 	SearchLake       SearchLakeValue       `tfsdk:"search_lake"`
 	TrafficFilterIds types.Set             `tfsdk:"traffic_filter_ids"`
 	Type             types.String          `tfsdk:"type"`
+	Timeouts         TimeoutsValue         `tfsdk:"timeouts"`
 }
 
 var _ basetypes.ObjectTypable = CredentialsType{}
@@ -3319,3 +3366,492 @@ func (v SearchLakeValue) AttributeTypes(ctx context.Context) map[string]attr.Typ
 		"search_power": basetypes.Int64Type{},
 	}
 }
+
+var _ basetypes.ObjectTypable = TimeoutsType{}
+
+type TimeoutsType struct {
+	basetypes.ObjectType
+}
+
+func (t TimeoutsType) Equal(o attr.Type) bool {
+	other, ok := o.(TimeoutsType)
+
+	if !ok {
+		return false
+	}
+
+	return t.ObjectType.Equal(other.ObjectType)
+}
+
+func (t TimeoutsType) String() string {
+	return "TimeoutsType"
+}
+
+func (t TimeoutsType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributes := in.Attributes()
+
+	createAttribute, ok := attributes["create"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`create is missing from object`)
+
+		return nil, diags
+	}
+
+	createVal, ok := createAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`create expected to be basetypes.StringValue, was: %T`, createAttribute))
+	}
+
+	deleteAttribute, ok := attributes["delete"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`delete is missing from object`)
+
+		return nil, diags
+	}
+
+	deleteVal, ok := deleteAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`delete expected to be basetypes.StringValue, was: %T`, deleteAttribute))
+	}
+
+	pollIntervalAttribute, ok := attributes["poll_interval"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`poll_interval is missing from object`)
+
+		return nil, diags
+	}
+
+	pollIntervalVal, ok := pollIntervalAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`poll_interval expected to be basetypes.StringValue, was: %T`, pollIntervalAttribute))
+	}
+
+	updateAttribute, ok := attributes["update"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`update is missing from object`)
+
+		return nil, diags
+	}
+
+	updateVal, ok := updateAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`update expected to be basetypes.StringValue, was: %T`, updateAttribute))
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return TimeoutsValue{
+		Create:       createVal,
+		Delete:       deleteVal,
+		PollInterval: pollIntervalVal,
+		Update:       updateVal,
+		state:        attr.ValueStateKnown,
+	}, diags
+}
+
+func NewTimeoutsValueNull() TimeoutsValue {
+	return TimeoutsValue{
+		state: attr.ValueStateNull,
+	}
+}
+
+func NewTimeoutsValueUnknown() TimeoutsValue {
+	return TimeoutsValue{
+		state: attr.ValueStateUnknown,
+	}
+}
+
+func NewTimeoutsValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (TimeoutsValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
+	ctx := context.Background()
+
+	for name, attributeType := range attributeTypes {
+		attribute, ok := attributes[name]
+
+		if !ok {
+			diags.AddError(
+				"Missing TimeoutsValue Attribute Value",
+				"While creating a TimeoutsValue value, a missing attribute value was detected. "+
+					"A TimeoutsValue must contain values for all attributes, even if null or unknown. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("TimeoutsValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
+			)
+
+			continue
+		}
+
+		if !attributeType.Equal(attribute.Type(ctx)) {
+			diags.AddError(
+				"Invalid TimeoutsValue Attribute Type",
+				"While creating a TimeoutsValue value, an invalid attribute value was detected. "+
+					"A TimeoutsValue must use a matching attribute type for the value. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("TimeoutsValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
+					fmt.Sprintf("TimeoutsValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
+			)
+		}
+	}
+
+	for name := range attributes {
+		_, ok := attributeTypes[name]
+
+		if !ok {
+			diags.AddError(
+				"Extra TimeoutsValue Attribute Value",
+				"While creating a TimeoutsValue value, an extra attribute value was detected. "+
+					"A TimeoutsValue must not contain values beyond the expected attribute types. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("Extra TimeoutsValue Attribute Name: %s", name),
+			)
+		}
+	}
+
+	if diags.HasError() {
+		return NewTimeoutsValueUnknown(), diags
+	}
+
+	createAttribute, ok := attributes["create"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`create is missing from object`)
+
+		return NewTimeoutsValueUnknown(), diags
+	}
+
+	createVal, ok := createAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`create expected to be basetypes.StringValue, was: %T`, createAttribute))
+	}
+
+	deleteAttribute, ok := attributes["delete"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`delete is missing from object`)
+
+		return NewTimeoutsValueUnknown(), diags
+	}
+
+	deleteVal, ok := deleteAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`delete expected to be basetypes.StringValue, was: %T`, deleteAttribute))
+	}
+
+	pollIntervalAttribute, ok := attributes["poll_interval"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`poll_interval is missing from object`)
+
+		return NewTimeoutsValueUnknown(), diags
+	}
+
+	pollIntervalVal, ok := pollIntervalAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`poll_interval expected to be basetypes.StringValue, was: %T`, pollIntervalAttribute))
+	}
+
+	updateAttribute, ok := attributes["update"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`update is missing from object`)
+
+		return NewTimeoutsValueUnknown(), diags
+	}
+
+	updateVal, ok := updateAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`update expected to be basetypes.StringValue, was: %T`, updateAttribute))
+	}
+
+	if diags.HasError() {
+		return NewTimeoutsValueUnknown(), diags
+	}
+
+	return TimeoutsValue{
+		Create:       createVal,
+		Delete:       deleteVal,
+		PollInterval: pollIntervalVal,
+		Update:       updateVal,
+		state:        attr.ValueStateKnown,
+	}, diags
+}
+
+func NewTimeoutsValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) TimeoutsValue {
+	object, diags := NewTimeoutsValue(attributeTypes, attributes)
+
+	if diags.HasError() {
+		// This could potentially be added to the diag package.
+		diagsStrings := make([]string, 0, len(diags))
+
+		for _, diagnostic := range diags {
+			diagsStrings = append(diagsStrings, fmt.Sprintf(
+				"%s | %s | %s",
+				diagnostic.Severity(),
+				diagnostic.Summary(),
+				diagnostic.Detail()))
+		}
+
+		panic("NewTimeoutsValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
+	}
+
+	return object
+}
+
+func (t TimeoutsType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	if in.Type() == nil {
+		return NewTimeoutsValueNull(), nil
+	}
+
+	if !in.Type().Equal(t.TerraformType(ctx)) {
+		return nil, fmt.Errorf("expected %s, got %s", t.TerraformType(ctx), in.Type())
+	}
+
+	if !in.IsKnown() {
+		return NewTimeoutsValueUnknown(), nil
+	}
+
+	if in.IsNull() {
+		return NewTimeoutsValueNull(), nil
+	}
+
+	attributes := map[string]attr.Value{}
+
+	val := map[string]tftypes.Value{}
+
+	err := in.As(&val)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range val {
+		a, err := t.AttrTypes[k].ValueFromTerraform(ctx, v)
+
+		if err != nil {
+			return nil, err
+		}
+
+		attributes[k] = a
+	}
+
+	return NewTimeoutsValueMust(TimeoutsValue{}.AttributeTypes(ctx), attributes), nil
+}
+
+func (t TimeoutsType) ValueType(ctx context.Context) attr.Value {
+	return TimeoutsValue{}
+}
+
+var _ basetypes.ObjectValuable = TimeoutsValue{}
+
+type TimeoutsValue struct {
+	Create       basetypes.StringValue `tfsdk:"create"`
+	Delete       basetypes.StringValue `tfsdk:"delete"`
+	PollInterval basetypes.StringValue `tfsdk:"poll_interval"`
+	Update       basetypes.StringValue `tfsdk:"update"`
+	state        attr.ValueState
+}
+
+func (v TimeoutsValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	attrTypes := make(map[string]tftypes.Type, 4)
+
+	var val tftypes.Value
+	var err error
+
+	attrTypes["create"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["delete"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["poll_interval"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["update"] = basetypes.StringType{}.TerraformType(ctx)
+
+	objectType := tftypes.Object{AttributeTypes: attrTypes}
+
+	switch v.state {
+	case attr.ValueStateKnown:
+		vals := make(map[string]tftypes.Value, 4)
+
+		val, err = v.Create.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["create"] = val
+
+		val, err = v.Delete.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["delete"] = val
+
+		val, err = v.PollInterval.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["poll_interval"] = val
+
+		val, err = v.Update.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["update"] = val
+
+		if err := tftypes.ValidateValue(objectType, vals); err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		return tftypes.NewValue(objectType, vals), nil
+	case attr.ValueStateNull:
+		return tftypes.NewValue(objectType, nil), nil
+	case attr.ValueStateUnknown:
+		return tftypes.NewValue(objectType, tftypes.UnknownValue), nil
+	default:
+		panic(fmt.Sprintf("unhandled Object state in ToTerraformValue: %s", v.state))
+	}
+}
+
+func (v TimeoutsValue) IsNull() bool {
+	return v.state == attr.ValueStateNull
+}
+
+func (v TimeoutsValue) IsUnknown() bool {
+	return v.state == attr.ValueStateUnknown
+}
+
+func (v TimeoutsValue) String() string {
+	return "TimeoutsValue"
+}
+
+func (v TimeoutsValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributeTypes := map[string]attr.Type{
+		"create":        basetypes.StringType{},
+		"delete":        basetypes.StringType{},
+		"poll_interval": basetypes.StringType{},
+		"update":        basetypes.StringType{},
+	}
+
+	if v.IsNull() {
+		return types.ObjectNull(attributeTypes), diags
+	}
+
+	if v.IsUnknown() {
+		return types.ObjectUnknown(attributeTypes), diags
+	}
+
+	objVal, diags := types.ObjectValue(
+		attributeTypes,
+		map[string]attr.Value{
+			"create":        v.Create,
+			"delete":        v.Delete,
+			"poll_interval": v.PollInterval,
+			"update":        v.Update,
+		})
+
+	return objVal, diags
+}
+
+func (v TimeoutsValue) Equal(o attr.Value) bool {
+	other, ok := o.(TimeoutsValue)
+
+	if !ok {
+		return false
+	}
+
+	if v.state != other.state {
+		return false
+	}
+
+	if v.state != attr.ValueStateKnown {
+		return true
+	}
+
+	if !v.Create.Equal(other.Create) {
+		return false
+	}
+
+	if !v.Delete.Equal(other.Delete) {
+		return false
+	}
+
+	if !v.PollInterval.Equal(other.PollInterval) {
+		return false
+	}
+
+	if !v.Update.Equal(other.Update) {
+		return false
+	}
+
+	return true
+}
+
+func (v TimeoutsValue) Type(ctx context.Context) attr.Type {
+	return TimeoutsType{
+		basetypes.ObjectType{
+			AttrTypes: v.AttributeTypes(ctx),
+		},
+	}
+}
+
+func (v TimeoutsValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
+	return map[string]attr.Type{
+		"create":        basetypes.StringType{},
+		"delete":        basetypes.StringType{},
+		"poll_interval": basetypes.StringType{},
+		"update":        basetypes.StringType{},
+	}
+}
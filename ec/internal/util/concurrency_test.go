@@ -0,0 +1,57 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package util
+
+import (
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestForEachConcurrently(t *testing.T) {
+	var running, maxRunning atomic.Int32
+	items := []int{1, 2, 3, 4, 5, 6, 7, 8}
+
+	errs := ForEachConcurrently(items, 3, func(item int) error {
+		current := running.Add(1)
+		defer running.Add(-1)
+		for {
+			previous := maxRunning.Load()
+			if current <= previous || maxRunning.CompareAndSwap(previous, current) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+
+		if item%4 == 0 {
+			return errors.New("failed")
+		}
+		return nil
+	})
+
+	assert.LessOrEqual(t, maxRunning.Load(), int32(3))
+	assert.Equal(t, []error{nil, nil, nil, errors.New("failed"), nil, nil, nil, errors.New("failed")}, errs)
+}
+
+func TestForEachConcurrently_NoItems(t *testing.T) {
+	errs := ForEachConcurrently(nil, 2, func(int) error { return errors.New("unexpected call") })
+	assert.Empty(t, errs)
+}
@@ -0,0 +1,100 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package util
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/elastic/terraform-provider-ec/ec/internal/gen/serverless"
+)
+
+// BuiltInProjectRoles lists the predefined roles available in every serverless
+// project, keyed by project type.
+var BuiltInProjectRoles = map[string][]string{
+	"elasticsearch": {
+		"admin",
+		"developer",
+		"viewer",
+	},
+	"observability": {
+		"admin",
+		"editor",
+		"viewer",
+	},
+	"security": {
+		"admin",
+		"editor",
+		"viewer",
+		"t1-analyst",
+		"t2-analyst",
+		"t3-analyst",
+		"threat-intel-analyst",
+		"rule-author",
+		"soc-manager",
+		"endpoint-operations-analyst",
+		"platform-engineer",
+		"detections-admin",
+		"endpoint-policy-manager",
+	},
+}
+
+// ListCustomProjectRoles returns the custom roles defined in the serverless
+// project with the given type and ID.
+func ListCustomProjectRoles(ctx context.Context, client serverless.ClientWithResponsesInterface, projectType, id string) ([]serverless.ProjectRoleDetails, error) {
+	var (
+		rolesByProject *serverless.ProjectRolesByProjectID
+		statusCode     int
+		status         string
+		body           []byte
+	)
+
+	switch projectType {
+	case "elasticsearch":
+		resp, err := client.GetElasticsearchProjectRolesWithResponse(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		rolesByProject, statusCode, status, body = resp.JSON200, resp.StatusCode(), resp.Status(), resp.Body
+	case "observability":
+		resp, err := client.GetObservabilityProjectRolesWithResponse(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		rolesByProject, statusCode, status, body = resp.JSON200, resp.StatusCode(), resp.Status(), resp.Body
+	case "security":
+		resp, err := client.GetSecurityProjectRolesWithResponse(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		rolesByProject, statusCode, status, body = resp.JSON200, resp.StatusCode(), resp.Status(), resp.Body
+	default:
+		return nil, fmt.Errorf("unsupported project type %q", projectType)
+	}
+
+	if rolesByProject == nil {
+		return nil, fmt.Errorf("the API request failed with: %d %s\n%s", statusCode, status, body)
+	}
+
+	roles, ok := (*rolesByProject)[id]
+	if !ok || roles.Roles == nil {
+		return nil, nil
+	}
+
+	return *roles.Roles, nil
+}
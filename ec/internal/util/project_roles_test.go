@@ -0,0 +1,83 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package util
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/elastic/terraform-provider-ec/ec/internal/gen/serverless"
+	"github.com/elastic/terraform-provider-ec/ec/internal/gen/serverless/mocks"
+)
+
+func TestListCustomProjectRoles(t *testing.T) {
+	ctx := context.Background()
+	roles := []serverless.ProjectRoleDetails{{Name: "custom", Description: "A custom role"}}
+
+	t.Run("returns the custom roles of the project", func(t *testing.T) {
+		client := mocks.NewMockClientWithResponsesInterface(gomock.NewController(t))
+		client.EXPECT().
+			GetSecurityProjectRolesWithResponse(ctx, "id").
+			Return(&serverless.GetSecurityProjectRolesResponse{
+				HTTPResponse: &http.Response{StatusCode: 200},
+				JSON200:      &serverless.ProjectRolesByProjectID{"id": {Roles: &roles}},
+			}, nil)
+
+		got, err := ListCustomProjectRoles(ctx, client, "security", "id")
+		require.NoError(t, err)
+		require.Equal(t, roles, got)
+	})
+
+	t.Run("returns no roles when the project has none", func(t *testing.T) {
+		client := mocks.NewMockClientWithResponsesInterface(gomock.NewController(t))
+		client.EXPECT().
+			GetObservabilityProjectRolesWithResponse(ctx, "id").
+			Return(&serverless.GetObservabilityProjectRolesResponse{
+				HTTPResponse: &http.Response{StatusCode: 200},
+				JSON200:      &serverless.ProjectRolesByProjectID{},
+			}, nil)
+
+		got, err := ListCustomProjectRoles(ctx, client, "observability", "id")
+		require.NoError(t, err)
+		require.Empty(t, got)
+	})
+
+	t.Run("returns an error when the project is not found", func(t *testing.T) {
+		client := mocks.NewMockClientWithResponsesInterface(gomock.NewController(t))
+		client.EXPECT().
+			GetElasticsearchProjectRolesWithResponse(ctx, "id").
+			Return(&serverless.GetElasticsearchProjectRolesResponse{
+				HTTPResponse: &http.Response{StatusCode: 404, Status: "404 Not Found"},
+				Body:         []byte("not found"),
+			}, nil)
+
+		_, err := ListCustomProjectRoles(ctx, client, "elasticsearch", "id")
+		require.EqualError(t, err, "the API request failed with: 404 404 Not Found\nnot found")
+	})
+
+	t.Run("returns an error for unsupported project types", func(t *testing.T) {
+		client := mocks.NewMockClientWithResponsesInterface(gomock.NewController(t))
+
+		_, err := ListCustomProjectRoles(ctx, client, "unknown", "id")
+		require.EqualError(t, err, `unsupported project type "unknown"`)
+	})
+}
@@ -0,0 +1,66 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package validators
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+type positiveDuration struct{}
+
+func (v positiveDuration) Description(ctx context.Context) string {
+	return `Value must be a positive duration, e.g. "30m" or "1h30m"`
+}
+
+func (v positiveDuration) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v positiveDuration) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	d, err := time.ParseDuration(req.ConfigValue.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			v.Description(ctx),
+			fmt.Sprintf("Value is not a valid duration, got %q: %s", req.ConfigValue.ValueString(), err),
+		)
+		return
+	}
+
+	if d <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			v.Description(ctx),
+			fmt.Sprintf("Duration must be positive, got %q", req.ConfigValue.ValueString()),
+		)
+	}
+}
+
+// PositiveDuration returns a string validator that only accepts values which
+// can be parsed by time.ParseDuration and are greater than zero.
+func PositiveDuration() validator.String {
+	return positiveDuration{}
+}
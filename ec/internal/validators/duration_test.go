@@ -0,0 +1,83 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package validators_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/elastic/terraform-provider-ec/ec/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPositiveDuration(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   types.String
+		isValid bool
+	}{
+		{
+			name:    "null is valid",
+			value:   types.StringNull(),
+			isValid: true,
+		},
+		{
+			name:    "unknown is valid",
+			value:   types.StringUnknown(),
+			isValid: true,
+		},
+		{
+			name:    "positive duration is valid",
+			value:   types.StringValue("1h30m"),
+			isValid: true,
+		},
+		{
+			name:    "zero duration is invalid",
+			value:   types.StringValue("0s"),
+			isValid: false,
+		},
+		{
+			name:    "negative duration is invalid",
+			value:   types.StringValue("-5m"),
+			isValid: false,
+		},
+		{
+			name:    "malformed duration is invalid",
+			value:   types.StringValue("30 minutes"),
+			isValid: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := validators.PositiveDuration()
+			resp := validator.StringResponse{}
+			v.ValidateString(context.Background(), validator.StringRequest{
+				ConfigValue: tt.value,
+			}, &resp)
+
+			if tt.isValid {
+				require.False(t, resp.Diagnostics.HasError())
+			} else {
+				require.True(t, resp.Diagnostics.HasError())
+			}
+		})
+	}
+}
@@ -0,0 +1,220 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package validators
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// gcsCredentialsRequiredKeys are the keys a GCS service account JSON must
+// contain for Elasticsearch to authenticate against the bucket.
+var gcsCredentialsRequiredKeys = []string{"type", "project_id", "private_key", "client_email"}
+
+var s3ClientSettingRegex = regexp.MustCompile(`^s3\.client\.([^.]+)\.(access_key|secret_key)$`)
+
+type keystoreSettingRule struct {
+	pattern  *regexp.Regexp
+	validate func(value string) error
+}
+
+// keystoreSettingRules lists the known Elasticsearch secure settings, along
+// with the validation of their values.
+var keystoreSettingRules = []keystoreSettingRule{
+	{pattern: regexp.MustCompile(`^gcs\.client\.[^.]+\.credentials_file$`), validate: validateGCSCredentials},
+	{pattern: regexp.MustCompile(`^s3\.client\.[^.]+\.(access_key|secret_key|session_token|proxy\.username|proxy\.password)$`), validate: validateNonEmpty},
+	{pattern: regexp.MustCompile(`^azure\.client\.[^.]+\.(account|key|sas_token)$`), validate: validateNonEmpty},
+	{pattern: regexp.MustCompile(`^xpack\.security\.authc\.realms\.saml\.[^.]+\.(signing|encryption)\.(secure_key_passphrase|keystore\.secure_password|keystore\.secure_key_password)$`), validate: validateNonEmpty},
+	{pattern: regexp.MustCompile(`^xpack\.security\.authc\.realms\.oidc\.[^.]+\.(rp\.client_secret|http\.proxy\.secure_password)$`), validate: validateNonEmpty},
+	{pattern: regexp.MustCompile(`^(bootstrap\.password|keystore\.seed)$`)},
+	// Any other secure setting, such as the ones of the SSL, LDAP or watcher
+	// notification settings, follows the secure_ naming convention.
+	{pattern: regexp.MustCompile(`(^|\.)secure_[a-z_]+$`)},
+}
+
+// keystoreSettingPrefixes are the prefixes of the secure settings listed in
+// keystoreSettingRules. Other names under these prefixes are likely typos.
+var keystoreSettingPrefixes = []string{
+	"gcs.client.",
+	"s3.client.",
+	"azure.client.",
+	"xpack.security.authc.realms.saml.",
+	"xpack.security.authc.realms.oidc.",
+}
+
+// ValidateKeystoreSetting validates the value of the keystore setting name
+// against the requirements of the known Elasticsearch secure settings. A
+// warning scoped to namePath is raised for setting names under the prefix of
+// a known secure setting which are not a recognised secure setting, and value
+// errors are scoped to valuePath. Other setting names, as well as null and
+// unknown values, are skipped.
+func ValidateKeystoreSetting(namePath, valuePath path.Path, name string, value types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	idx := slices.IndexFunc(keystoreSettingRules, func(rule keystoreSettingRule) bool {
+		return rule.pattern.MatchString(name)
+	})
+	if idx < 0 {
+		if !slices.ContainsFunc(keystoreSettingPrefixes, func(prefix string) bool {
+			return strings.HasPrefix(name, prefix)
+		}) {
+			return diags
+		}
+
+		diags.AddAttributeWarning(
+			namePath,
+			"Unrecognised keystore setting",
+			fmt.Sprintf("%q is not a recognised Elasticsearch secure setting, so it may be ignored by Elasticsearch. Check the setting name against the documentation of the plugin or feature it configures.", name),
+		)
+		return diags
+	}
+
+	rule := keystoreSettingRules[idx]
+	if rule.validate == nil || value.IsNull() || value.IsUnknown() {
+		return diags
+	}
+
+	if err := rule.validate(value.ValueString()); err != nil {
+		diags.AddAttributeError(
+			valuePath,
+			"Invalid keystore setting value",
+			fmt.Sprintf("Invalid value for keystore setting %q: %s", name, err),
+		)
+	}
+
+	return diags
+}
+
+// ValidateKeystoreSettingPairs validates that settings which only work
+// together, such as the S3 client access and secret keys, are all set.
+// pathOf returns the path which the diagnostics of a setting are scoped to.
+func ValidateKeystoreSettingPairs(names []string, pathOf func(name string) path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, name := range names {
+		matches := s3ClientSettingRegex.FindStringSubmatch(name)
+		if matches == nil {
+			continue
+		}
+
+		counterpart := fmt.Sprintf("s3.client.%s.secret_key", matches[1])
+		if matches[2] == "secret_key" {
+			counterpart = fmt.Sprintf("s3.client.%s.access_key", matches[1])
+		}
+
+		if !slices.Contains(names, counterpart) {
+			diags.AddAttributeError(
+				pathOf(name),
+				"Incomplete keystore settings",
+				fmt.Sprintf("Keystore setting %q requires %q to be set as well.", name, counterpart),
+			)
+		}
+	}
+
+	return diags
+}
+
+func validateNonEmpty(value string) error {
+	if strings.TrimSpace(value) == "" {
+		return fmt.Errorf("value must not be empty")
+	}
+	return nil
+}
+
+func validateGCSCredentials(value string) error {
+	var credentials map[string]any
+	if err := json.Unmarshal([]byte(value), &credentials); err != nil {
+		return fmt.Errorf("value must be a GCS service account JSON: %w", err)
+	}
+
+	var missing []string
+	for _, key := range gcsCredentialsRequiredKeys {
+		if v, ok := credentials[key].(string); !ok || v == "" {
+			missing = append(missing, key)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("the GCS service account JSON is missing the required keys: %s", strings.Join(missing, ", "))
+	}
+
+	if credentials["type"] != "service_account" {
+		return fmt.Errorf(`the GCS credentials must be of type "service_account", got %q`, credentials["type"])
+	}
+
+	return nil
+}
+
+type keystoreSettingsValidator struct{}
+
+func (v keystoreSettingsValidator) Description(ctx context.Context) string {
+	return "Keystore settings must hold valid values for the known Elasticsearch secure settings"
+}
+
+func (v keystoreSettingsValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v keystoreSettingsValidator) ValidateMap(ctx context.Context, req validator.MapRequest, resp *validator.MapResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	elements := req.ConfigValue.Elements()
+	names := slices.Sorted(maps.Keys(elements))
+
+	for _, name := range names {
+		obj, ok := elements[name].(types.Object)
+		if !ok || obj.IsNull() || obj.IsUnknown() {
+			continue
+		}
+
+		valuePath := req.Path.AtMapKey(name).AtName("value")
+		value, _ := obj.Attributes()["value"].(types.String)
+		if value.IsNull() {
+			if valueWO, ok := obj.Attributes()["value_wo"].(types.String); ok && !valueWO.IsNull() {
+				valuePath = req.Path.AtMapKey(name).AtName("value_wo")
+				value = valueWO
+			}
+		}
+
+		resp.Diagnostics.Append(ValidateKeystoreSetting(req.Path.AtMapKey(name), valuePath, name, value)...)
+	}
+
+	resp.Diagnostics.Append(ValidateKeystoreSettingPairs(names, func(name string) path.Path {
+		return req.Path.AtMapKey(name)
+	})...)
+}
+
+// KeystoreSettings returns a validator which validates a map of keystore
+// settings, keyed by setting name, whose elements hold the setting value in
+// a `value` or `value_wo` attribute.
+//
+// Null (unconfigured) and unknown values are skipped.
+func KeystoreSettings() validator.Map {
+	return keystoreSettingsValidator{}
+}
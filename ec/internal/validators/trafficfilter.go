@@ -0,0 +1,373 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package validators
+
+import (
+	"fmt"
+	"net/netip"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Traffic filter ruleset types.
+const (
+	TrafficFilterTypeIP                    = "ip"
+	TrafficFilterTypeVPCE                  = "vpce"
+	TrafficFilterTypeAzurePrivateEndpoint  = "azure_private_endpoint"
+	TrafficFilterTypeGCPPrivateServiceConn = "gcp_private_service_connect_endpoint"
+	TrafficFilterTypeRemoteCluster         = "remote_cluster"
+)
+
+var (
+	vpcEndpointIDRegex = regexp.MustCompile(`^vpce-[0-9a-z]+$`)
+	pscConnectionRegex = regexp.MustCompile(`^[0-9]+$`)
+	guidRegex          = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+)
+
+// TrafficFilterRule holds the attributes of a traffic filter rule to
+// validate, along with the path of the rule in the configuration. Attributes
+// which the resource doesn't support are left null.
+type TrafficFilterRule struct {
+	Path               path.Path
+	Source             types.String
+	AzureEndpointName  types.String
+	AzureEndpointGUID  types.String
+	RemoteClusterID    types.String
+	RemoteClusterOrgID types.String
+}
+
+// ValidateTrafficFilterRules validates the rules of a ruleset of type
+// rulesetType: each rule must only set the attributes supported by the type,
+// sources must be valid for the type (IPv4 or IPv6 addresses and CIDR blocks
+// for `ip` rulesets), and rules must not be duplicated. Rules already allowed
+// by a wider CIDR block of the ruleset, and CIDR blocks with host bits set,
+// raise warnings.
+//
+// Unknown values are skipped, and rules of unknown ruleset types are left to
+// the API to validate.
+func ValidateTrafficFilterRules(rulesetType string, rules []TrafficFilterRule) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, rule := range rules {
+		diags.Append(validateTrafficFilterRuleAttributes(rulesetType, rule)...)
+	}
+
+	switch rulesetType {
+	case TrafficFilterTypeIP:
+		diags.Append(validateIPRules(rules)...)
+	case TrafficFilterTypeVPCE:
+		diags.Append(validateSourceFormat(rules, vpcEndpointIDRegex, "a VPC endpoint ID, e.g. vpce-00000000000000000")...)
+	case TrafficFilterTypeGCPPrivateServiceConn:
+		diags.Append(validateSourceFormat(rules, pscConnectionRegex, "a Private Service Connect connection ID, e.g. 18446744072646845332")...)
+	case TrafficFilterTypeAzurePrivateEndpoint:
+		diags.Append(validateAzureRules(rules)...)
+	case TrafficFilterTypeRemoteCluster:
+		diags.Append(validateRemoteClusterRules(rules)...)
+	}
+
+	return diags
+}
+
+// ValidateServerlessTrafficFilterRules validates the rules of a serverless
+// traffic filter of type filterType, the same way as ValidateTrafficFilterRules.
+// Serverless `vpce` filters hold the private connection ID of any cloud
+// provider, so only their duplicates are reported.
+func ValidateServerlessTrafficFilterRules(filterType string, rules []TrafficFilterRule) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	switch filterType {
+	case TrafficFilterTypeIP:
+		for _, rule := range rules {
+			diags.Append(validateTrafficFilterRuleAttributes(filterType, rule)...)
+		}
+		diags.Append(validateIPRules(rules)...)
+	case TrafficFilterTypeVPCE:
+		diags.Append(validateSourceFormat(rules, nil, "")...)
+	}
+
+	return diags
+}
+
+func validateTrafficFilterRuleAttributes(rulesetType string, rule TrafficFilterRule) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	knownType := true
+	requiresSource := false
+	switch rulesetType {
+	case TrafficFilterTypeIP, TrafficFilterTypeVPCE, TrafficFilterTypeGCPPrivateServiceConn:
+		requiresSource = true
+	case TrafficFilterTypeAzurePrivateEndpoint, TrafficFilterTypeRemoteCluster:
+	default:
+		knownType = false
+	}
+
+	// Validate remote_cluster fields
+	if rulesetType != TrafficFilterTypeRemoteCluster && (isSet(rule.RemoteClusterID) || isSet(rule.RemoteClusterOrgID)) {
+		diags.AddAttributeError(
+			rule.Path,
+			"Invalid Rule Configuration",
+			"The 'remote_cluster_id' and 'remote_cluster_org_id' attributes can only be specified when 'type' is set to 'remote_cluster'.",
+		)
+	}
+
+	// Only validate required fields if values are known (not unknown due to variables)
+	// Unknown values will be validated at apply time when they become known
+	if rulesetType == TrafficFilterTypeRemoteCluster && !rule.RemoteClusterID.IsUnknown() && !rule.RemoteClusterOrgID.IsUnknown() {
+		if rule.RemoteClusterID.IsNull() || rule.RemoteClusterOrgID.IsNull() {
+			diags.AddAttributeError(
+				rule.Path,
+				"Missing Required Attributes",
+				"Both 'remote_cluster_id' and 'remote_cluster_org_id' are required when 'type' is set to 'remote_cluster'.",
+			)
+		}
+	}
+
+	// Validate azure fields
+	if rulesetType != TrafficFilterTypeAzurePrivateEndpoint && (isSet(rule.AzureEndpointName) || isSet(rule.AzureEndpointGUID)) {
+		diags.AddAttributeError(
+			rule.Path,
+			"Invalid Rule Configuration",
+			"The 'azure_endpoint_name' and 'azure_endpoint_guid' attributes can only be specified when 'type' is set to 'azure_private_endpoint'.",
+		)
+	}
+
+	if rulesetType == TrafficFilterTypeAzurePrivateEndpoint && !rule.AzureEndpointName.IsUnknown() && !rule.AzureEndpointGUID.IsUnknown() {
+		if rule.AzureEndpointName.IsNull() || rule.AzureEndpointGUID.IsNull() {
+			diags.AddAttributeError(
+				rule.Path,
+				"Missing Required Attributes",
+				"Both 'azure_endpoint_name' and 'azure_endpoint_guid' are required when 'type' is set to 'azure_private_endpoint'.",
+			)
+		}
+	}
+
+	// Validate the source
+	if !knownType {
+		return diags
+	}
+
+	if requiresSource && rule.Source.IsNull() {
+		diags.AddAttributeError(
+			rule.Path,
+			"Missing Required Attributes",
+			fmt.Sprintf("The 'source' attribute is required when 'type' is set to '%s'.", rulesetType),
+		)
+	}
+
+	if !requiresSource && isSet(rule.Source) {
+		diags.AddAttributeError(
+			rule.Path.AtName("source"),
+			"Invalid Rule Configuration",
+			fmt.Sprintf("The 'source' attribute can't be specified when 'type' is set to '%s'.", rulesetType),
+		)
+	}
+
+	return diags
+}
+
+// validateIPRules validates that the rule sources are IPv4 or IPv6 addresses
+// or CIDR blocks, and reports duplicated and overlapping sources.
+func validateIPRules(rules []TrafficFilterRule) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	type ipRule struct {
+		path   path.Path
+		source string
+		prefix netip.Prefix
+	}
+
+	var parsed []ipRule
+	seen := make(map[netip.Prefix]string)
+	for _, rule := range rules {
+		if !isSet(rule.Source) {
+			continue
+		}
+
+		sourcePath := rule.Path.AtName("source")
+		source := rule.Source.ValueString()
+		prefix, err := parseIPSource(source)
+		if err != nil {
+			diags.AddAttributeError(
+				sourcePath,
+				"Invalid Traffic Filter Source",
+				fmt.Sprintf("%q is not a valid IPv4 or IPv6 address or CIDR block: %s", source, err),
+			)
+			continue
+		}
+
+		if masked := prefix.Masked(); masked != prefix {
+			diags.AddAttributeWarning(
+				sourcePath,
+				"Traffic Filter Source Has Host Bits Set",
+				fmt.Sprintf("%q has host bits set, it allows the same addresses as %q.", source, masked.String()),
+			)
+		}
+
+		if other, ok := seen[prefix]; ok {
+			diags.AddAttributeError(
+				sourcePath,
+				"Duplicate Traffic Filter Rule",
+				fmt.Sprintf("%q is the same source as %q, which is already in the ruleset.", source, other),
+			)
+			continue
+		}
+		seen[prefix] = source
+
+		parsed = append(parsed, ipRule{path: sourcePath, source: source, prefix: prefix})
+	}
+
+	for i, rule := range parsed {
+		network := rule.prefix.Masked()
+		for j, other := range parsed {
+			if i == j {
+				continue
+			}
+
+			otherNetwork := other.prefix.Masked()
+			// Report the narrower source, or the later one when both allow the same addresses.
+			if otherNetwork.Bits() > network.Bits() || (otherNetwork.Bits() == network.Bits() && j > i) {
+				continue
+			}
+
+			if otherNetwork.Contains(network.Addr()) {
+				diags.AddAttributeWarning(
+					rule.path,
+					"Overlapping Traffic Filter Rules",
+					fmt.Sprintf("%q is already allowed by %q, consider removing it from the ruleset.", rule.source, other.source),
+				)
+				break
+			}
+		}
+	}
+
+	return diags
+}
+
+func parseIPSource(source string) (netip.Prefix, error) {
+	if strings.Contains(source, "/") {
+		return netip.ParsePrefix(source)
+	}
+
+	addr, err := netip.ParseAddr(source)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+	if addr.Zone() != "" {
+		return netip.Prefix{}, fmt.Errorf("IPv6 zones are not supported")
+	}
+
+	return netip.PrefixFrom(addr, addr.BitLen()), nil
+}
+
+// validateSourceFormat validates that the rule sources match regex, when set,
+// and reports duplicated sources.
+func validateSourceFormat(rules []TrafficFilterRule, regex *regexp.Regexp, format string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	seen := make(map[string]bool)
+	for _, rule := range rules {
+		if !isSet(rule.Source) {
+			continue
+		}
+
+		source := rule.Source.ValueString()
+		if regex != nil && !regex.MatchString(source) {
+			diags.AddAttributeError(
+				rule.Path.AtName("source"),
+				"Invalid Traffic Filter Source",
+				fmt.Sprintf("%q is not %s.", source, format),
+			)
+			continue
+		}
+
+		if seen[source] {
+			diags.AddAttributeError(
+				rule.Path.AtName("source"),
+				"Duplicate Traffic Filter Rule",
+				fmt.Sprintf("%q is already in the ruleset.", source),
+			)
+		}
+		seen[source] = true
+	}
+
+	return diags
+}
+
+func validateAzureRules(rules []TrafficFilterRule) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	seen := make(map[string]bool)
+	for _, rule := range rules {
+		if !isSet(rule.AzureEndpointGUID) {
+			continue
+		}
+
+		guid := rule.AzureEndpointGUID.ValueString()
+		if !guidRegex.MatchString(guid) {
+			diags.AddAttributeError(
+				rule.Path.AtName("azure_endpoint_guid"),
+				"Invalid Azure Endpoint GUID",
+				fmt.Sprintf("%q is not a GUID, e.g. 78c64959-fd88-41cc-81ac-1cfcdb1ac32e.", guid),
+			)
+			continue
+		}
+
+		if key := strings.ToLower(guid); seen[key] {
+			diags.AddAttributeError(
+				rule.Path.AtName("azure_endpoint_guid"),
+				"Duplicate Traffic Filter Rule",
+				fmt.Sprintf("The Azure endpoint %q is already in the ruleset.", guid),
+			)
+		} else {
+			seen[key] = true
+		}
+	}
+
+	return diags
+}
+
+func validateRemoteClusterRules(rules []TrafficFilterRule) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	seen := make(map[string]bool)
+	for _, rule := range rules {
+		if !isSet(rule.RemoteClusterID) || !isSet(rule.RemoteClusterOrgID) {
+			continue
+		}
+
+		key := rule.RemoteClusterOrgID.ValueString() + "/" + rule.RemoteClusterID.ValueString()
+		if seen[key] {
+			diags.AddAttributeError(
+				rule.Path,
+				"Duplicate Traffic Filter Rule",
+				fmt.Sprintf("The remote cluster %q of organization %q is already in the ruleset.", rule.RemoteClusterID.ValueString(), rule.RemoteClusterOrgID.ValueString()),
+			)
+		}
+		seen[key] = true
+	}
+
+	return diags
+}
+
+func isSet(value types.String) bool {
+	return !value.IsNull() && !value.IsUnknown()
+}
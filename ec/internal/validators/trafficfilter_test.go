@@ -0,0 +1,212 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package validators_test
+
+import (
+	"testing"
+
+	"github.com/elastic/terraform-provider-ec/ec/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateTrafficFilterRules(t *testing.T) {
+	sources := func(values ...string) []validators.TrafficFilterRule {
+		var rules []validators.TrafficFilterRule
+		for i, value := range values {
+			rules = append(rules, validators.TrafficFilterRule{
+				Path:   path.Root("rule").AtListIndex(i),
+				Source: types.StringValue(value),
+			})
+		}
+		return rules
+	}
+
+	tests := []struct {
+		name         string
+		rulesetType  string
+		rules        []validators.TrafficFilterRule
+		wantErrors   []string
+		wantWarnings []string
+	}{
+		{
+			name:        "valid IPv4 and IPv6 sources",
+			rulesetType: "ip",
+			rules:       sources("1.1.1.1", "10.0.0.0/8", "2001:db8::/32", "2001:db9::1"),
+		},
+		{
+			name:        "invalid CIDR",
+			rulesetType: "ip",
+			rules:       sources("10.0.0.0/33", "not-an-ip"),
+			wantErrors:  []string{`"10.0.0.0/33" is not a valid IPv4 or IPv6 address or CIDR block`, `"not-an-ip" is not a valid IPv4 or IPv6 address or CIDR block`},
+		},
+		{
+			name:         "host bits set",
+			rulesetType:  "ip",
+			rules:        sources("10.0.0.1/24"),
+			wantWarnings: []string{`"10.0.0.1/24" has host bits set, it allows the same addresses as "10.0.0.0/24"`},
+		},
+		{
+			name:        "duplicated source",
+			rulesetType: "ip",
+			rules:       sources("10.0.0.1", "10.0.0.1/32"),
+			wantErrors:  []string{`"10.0.0.1/32" is the same source as "10.0.0.1"`},
+		},
+		{
+			name:        "duplicated IPv6 source written differently",
+			rulesetType: "ip",
+			rules:       sources("2001:db8::/32", "2001:0db8:0::/32"),
+			wantErrors:  []string{`"2001:0db8:0::/32" is the same source as "2001:db8::/32"`},
+		},
+		{
+			name:         "overlapping sources",
+			rulesetType:  "ip",
+			rules:        sources("10.1.2.3", "10.0.0.0/8", "192.168.0.0/16"),
+			wantWarnings: []string{`"10.1.2.3" is already allowed by "10.0.0.0/8"`},
+		},
+		{
+			name:        "IPv4 and IPv6 don't overlap",
+			rulesetType: "ip",
+			rules:       sources("0.0.0.0/0", "::/0"),
+		},
+		{
+			name:        "missing source",
+			rulesetType: "ip",
+			rules:       []validators.TrafficFilterRule{{Path: path.Root("rule").AtListIndex(0), Source: types.StringNull()}},
+			wantErrors:  []string{"The 'source' attribute is required when 'type' is set to 'ip'."},
+		},
+		{
+			name:        "unknown sources are skipped",
+			rulesetType: "ip",
+			rules:       []validators.TrafficFilterRule{{Path: path.Root("rule").AtListIndex(0), Source: types.StringUnknown()}},
+		},
+		{
+			name:        "azure attributes on an ip ruleset",
+			rulesetType: "ip",
+			rules: []validators.TrafficFilterRule{{
+				Path:              path.Root("rule").AtListIndex(0),
+				Source:            types.StringValue("1.1.1.1"),
+				AzureEndpointName: types.StringValue("my-azure-pl"),
+				AzureEndpointGUID: types.StringValue("78c64959-fd88-41cc-81ac-1cfcdb1ac32e"),
+			}},
+			wantErrors: []string{"The 'azure_endpoint_name' and 'azure_endpoint_guid' attributes can only be specified when 'type' is set to 'azure_private_endpoint'."},
+		},
+		{
+			name:        "valid vpce sources",
+			rulesetType: "vpce",
+			rules:       sources("vpce-00000000000000000", "vpce-0123abcd"),
+		},
+		{
+			name:        "invalid and duplicated vpce sources",
+			rulesetType: "vpce",
+			rules:       sources("10.0.0.0/8", "vpce-0123abcd", "vpce-0123abcd"),
+			wantErrors:  []string{`"10.0.0.0/8" is not a VPC endpoint ID`, `"vpce-0123abcd" is already in the ruleset`},
+		},
+		{
+			name:        "invalid Private Service Connect connection ID",
+			rulesetType: "gcp_private_service_connect_endpoint",
+			rules:       sources("psc-123"),
+			wantErrors:  []string{`"psc-123" is not a Private Service Connect connection ID`},
+		},
+		{
+			name:        "azure rules",
+			rulesetType: "azure_private_endpoint",
+			rules: []validators.TrafficFilterRule{
+				{
+					Path:              path.Root("rule").AtListIndex(0),
+					Source:            types.StringValue("1.1.1.1"),
+					AzureEndpointName: types.StringValue("my-azure-pl"),
+					AzureEndpointGUID: types.StringValue("not-a-guid"),
+				},
+				{
+					Path:              path.Root("rule").AtListIndex(1),
+					AzureEndpointName: types.StringValue("my-other-azure-pl"),
+				},
+			},
+			wantErrors: []string{
+				"The 'source' attribute can't be specified when 'type' is set to 'azure_private_endpoint'.",
+				"Both 'azure_endpoint_name' and 'azure_endpoint_guid' are required when 'type' is set to 'azure_private_endpoint'.",
+				`"not-a-guid" is not a GUID`,
+			},
+		},
+		{
+			name:        "duplicated remote clusters",
+			rulesetType: "remote_cluster",
+			rules: []validators.TrafficFilterRule{
+				{Path: path.Root("rule").AtListIndex(0), RemoteClusterID: types.StringValue("cluster"), RemoteClusterOrgID: types.StringValue("org")},
+				{Path: path.Root("rule").AtListIndex(1), RemoteClusterID: types.StringValue("cluster"), RemoteClusterOrgID: types.StringValue("org")},
+			},
+			wantErrors: []string{`The remote cluster "cluster" of organization "org" is already in the ruleset.`},
+		},
+		{
+			name:        "missing remote cluster organization",
+			rulesetType: "remote_cluster",
+			rules: []validators.TrafficFilterRule{
+				{Path: path.Root("rule").AtListIndex(0), RemoteClusterID: types.StringValue("cluster")},
+			},
+			wantErrors: []string{"Both 'remote_cluster_id' and 'remote_cluster_org_id' are required when 'type' is set to 'remote_cluster'."},
+		},
+		{
+			name:        "unknown ruleset types only validate the attributes of other types",
+			rulesetType: "new_type",
+			rules:       sources("anything"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := validators.ValidateTrafficFilterRules(tt.rulesetType, tt.rules)
+			requireDiagnostics(t, diags.Errors(), tt.wantErrors)
+			requireDiagnostics(t, diags.Warnings(), tt.wantWarnings)
+		})
+	}
+}
+
+func TestValidateServerlessTrafficFilterRules(t *testing.T) {
+	rules := func(values ...string) []validators.TrafficFilterRule {
+		var rules []validators.TrafficFilterRule
+		for i, value := range values {
+			rules = append(rules, validators.TrafficFilterRule{
+				Path:   path.Root("rules").AtListIndex(i),
+				Source: types.StringValue(value),
+			})
+		}
+		return rules
+	}
+
+	require.Empty(t, validators.ValidateServerlessTrafficFilterRules("vpce", rules("vpce-0123abcd", "/subscriptions/id/resourceGroups/group")))
+
+	diags := validators.ValidateServerlessTrafficFilterRules("vpce", rules("18446744072646845332", "18446744072646845332"))
+	requireDiagnostics(t, diags.Errors(), []string{`"18446744072646845332" is already in the ruleset`})
+
+	diags = validators.ValidateServerlessTrafficFilterRules("ip", rules("192.168.1.0/24", "192.168.1.1/24"))
+	requireDiagnostics(t, diags.Errors(), nil)
+	requireDiagnostics(t, diags.Warnings(), []string{
+		`"192.168.1.1/24" has host bits set`,
+		`"192.168.1.1/24" is already allowed by "192.168.1.0/24"`,
+	})
+}
+
+func requireDiagnostics(t *testing.T, diags diag.Diagnostics, want []string) {
+	t.Helper()
+	require.Len(t, diags, len(want), diags)
+	for i, d := range diags {
+		require.Contains(t, d.Detail(), want[i])
+	}
+}
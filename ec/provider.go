@@ -33,6 +33,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -43,15 +44,26 @@ import (
 	"github.com/elastic/cloud-sdk-go/pkg/api"
 	"github.com/elastic/terraform-provider-ec/ec/ecdatasource/deploymentdatasource"
 	"github.com/elastic/terraform-provider-ec/ec/ecdatasource/deploymentsdatasource"
+	"github.com/elastic/terraform-provider-ec/ec/ecdatasource/extensiondatasource"
+	"github.com/elastic/terraform-provider-ec/ec/ecdatasource/organizationdatasource"
 	"github.com/elastic/terraform-provider-ec/ec/ecdatasource/privatelinkdatasource"
+	"github.com/elastic/terraform-provider-ec/ec/ecdatasource/projectlinkcandidatesdatasource"
+	"github.com/elastic/terraform-provider-ec/ec/ecdatasource/projectrolesdatasource"
+	"github.com/elastic/terraform-provider-ec/ec/ecdatasource/serverlesstrafficfilterdatasource"
+	"github.com/elastic/terraform-provider-ec/ec/ecdatasource/snapshotrepositorydatasource"
 	"github.com/elastic/terraform-provider-ec/ec/ecdatasource/stackdatasource"
 	"github.com/elastic/terraform-provider-ec/ec/ecdatasource/trafficfilterdatasource"
+	"github.com/elastic/terraform-provider-ec/ec/ecephemeral/deploymentcredentialsephemeral"
+	"github.com/elastic/terraform-provider-ec/ec/ecephemeral/projectcredentialsephemeral"
+	"github.com/elastic/terraform-provider-ec/ec/ecresource/apikeyresource"
 	"github.com/elastic/terraform-provider-ec/ec/ecresource/deploymentresource"
 	"github.com/elastic/terraform-provider-ec/ec/ecresource/elasticsearchkeystoreresource"
 	"github.com/elastic/terraform-provider-ec/ec/ecresource/extensionresource"
 	"github.com/elastic/terraform-provider-ec/ec/ecresource/projectresource"
 	"github.com/elastic/terraform-provider-ec/ec/ecresource/snapshotrepositoryresource"
 	"github.com/elastic/terraform-provider-ec/ec/ecresource/trafficfilterassocresource"
+	"github.com/elastic/terraform-provider-ec/ec/ecresource/trafficfilterassocsresource"
+	"github.com/elastic/terraform-provider-ec/ec/ecresource/trafficfiltergroupresource"
 	"github.com/elastic/terraform-provider-ec/ec/ecresource/trafficfilterresource"
 	"github.com/elastic/terraform-provider-ec/ec/internal/util"
 	"github.com/elastic/terraform-provider-ec/ec/internal/validators"
@@ -90,6 +102,7 @@ func ProviderWithClient(client *api.API, version string) provider.Provider {
 }
 
 var _ provider.Provider = (*Provider)(nil)
+var _ provider.ProviderWithEphemeralResources = (*Provider)(nil)
 
 type Provider struct {
 	version   string
@@ -107,26 +120,47 @@ func (p *Provider) DataSources(ctx context.Context) []func() datasource.DataSour
 		func() datasource.DataSource { return &deploymentsdatasource.DataSource{} },
 		func() datasource.DataSource { return &stackdatasource.DataSource{} },
 		func() datasource.DataSource { return &trafficfilterdatasource.DataSource{} },
+		func() datasource.DataSource { return &extensiondatasource.DataSource{} },
+		func() datasource.DataSource { return &extensiondatasource.ExtensionDataSource{} },
+		func() datasource.DataSource { return &snapshotrepositorydatasource.DataSource{} },
+		func() datasource.DataSource { return &snapshotrepositorydatasource.RepositoryDataSource{} },
 		privatelinkdatasource.AwsDataSource,
 		privatelinkdatasource.GcpDataSource,
 		privatelinkdatasource.AzureDataSource,
 		func() datasource.DataSource { return &deploymenttemplates.DataSource{} },
+		func() datasource.DataSource { return &projectlinkcandidatesdatasource.DataSource{} },
+		func() datasource.DataSource { return &projectrolesdatasource.DataSource{} },
+		func() datasource.DataSource { return &serverlesstrafficfilterdatasource.DataSource{} },
+		func() datasource.DataSource { return &serverlesstrafficfilterdatasource.MetadataDataSource{} },
+		func() datasource.DataSource { return &organizationdatasource.DataSource{} },
 	}
 }
 
 func (p *Provider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		func() resource.Resource { return &elasticsearchkeystoreresource.Resource{} },
+		func() resource.Resource { return &elasticsearchkeystoreresource.SettingsResource{} },
 		func() resource.Resource { return &extensionresource.Resource{} },
 		func() resource.Resource { return &deploymentresource.Resource{} },
 		func() resource.Resource { return &snapshotrepositoryresource.Resource{} },
 		func() resource.Resource { return &trafficfilterresource.Resource{} },
 		func() resource.Resource { return &trafficfilterassocresource.Resource{} },
+		func() resource.Resource { return &trafficfilterassocsresource.Resource{} },
+		func() resource.Resource { return &trafficfiltergroupresource.Resource{} },
 		func() resource.Resource { return projectresource.NewElasticsearchProjectResource() },
 		func() resource.Resource { return projectresource.NewObservabilityProjectResource() },
 		func() resource.Resource { return projectresource.NewSecurityProjectResource() },
 		func() resource.Resource { return serverlesstrafficfilterresource.New() },
 		func() resource.Resource { return &organizationresource.Resource{} },
+		func() resource.Resource { return &organizationresource.MemberResource{} },
+		func() resource.Resource { return &apikeyresource.Resource{} },
+	}
+}
+
+func (p *Provider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		func() ephemeral.EphemeralResource { return &deploymentcredentialsephemeral.EphemeralResource{} },
+		func() ephemeral.EphemeralResource { return &projectcredentialsephemeral.EphemeralResource{} },
 	}
 }
 
@@ -200,6 +234,7 @@ func (p *Provider) Configure(ctx context.Context, req provider.ConfigureRequest,
 		// Required for unit tests, because a mock client is pre-created there.
 		resp.DataSourceData = data
 		resp.ResourceData = data
+		resp.EphemeralResourceData = data
 		return
 	}
 
@@ -360,6 +395,7 @@ func (p *Provider) Configure(ctx context.Context, req provider.ConfigureRequest,
 	}
 	resp.DataSourceData = data
 	resp.ResourceData = data
+	resp.EphemeralResourceData = data
 }
 
 func validateEndpoint(ctx context.Context, endpoint string) diag.Diagnostics {
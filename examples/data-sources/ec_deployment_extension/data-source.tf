@@ -0,0 +1,7 @@
+data "ec_deployment_extension" "synonyms" {
+  id = "4b1e0e4c9e4f4cbe8fa2b6e2f4c1d0a7"
+}
+
+output "synonyms_deployments" {
+  value = data.ec_deployment_extension.synonyms.deployments
+}
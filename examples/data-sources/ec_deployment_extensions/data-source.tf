@@ -0,0 +1,29 @@
+data "ec_deployment_extensions" "synonyms" {
+  name           = "synonyms"
+  extension_type = "bundle"
+  # Returns the extensions compatible with this stack version.
+  version = "8.15.0"
+}
+
+data "ec_stack" "latest" {
+  version_regex = "latest"
+  region        = "us-east-1"
+}
+
+resource "ec_deployment" "with_extension" {
+  region                 = "us-east-1"
+  version                = data.ec_stack.latest.version
+  deployment_template_id = "aws-io-optimized-v2"
+
+  elasticsearch = {
+    hot = {
+      autoscaling = {}
+    }
+    extension = [for extension in data.ec_deployment_extensions.synonyms.extensions : {
+      name    = extension.name
+      type    = extension.extension_type
+      version = data.ec_stack.latest.version
+      url     = extension.url
+    }]
+  }
+}
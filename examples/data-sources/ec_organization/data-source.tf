@@ -0,0 +1,33 @@
+resource "ec_deployment" "example" {
+  name                   = "example"
+  region                 = "us-east-1"
+  version                = "8.17.0"
+  deployment_template_id = "aws-io-optimized-v2"
+
+  elasticsearch = {
+    hot = {
+      autoscaling = {}
+    }
+  }
+}
+
+# Members and pending invitations with a role on the deployment
+data "ec_organization" "deployment_users" {
+  deployment_id = ec_deployment.example.id
+}
+
+# Organization admins, e.g. to notify them
+data "ec_organization" "admins" {
+  role = "organization-admin"
+}
+
+output "deployment_users" {
+  value = concat(
+    [for member in data.ec_organization.deployment_users.members : member.email],
+    [for invitation in data.ec_organization.deployment_users.invitations : invitation.email],
+  )
+}
+
+output "admin_emails" {
+  value = [for member in data.ec_organization.admins.members : member.email]
+}
@@ -0,0 +1,15 @@
+resource "ec_elasticsearch_project" "search" {
+  name      = "search"
+  region_id = "aws-us-east-1"
+}
+
+data "ec_project_link_candidates" "observability" {
+  project_id   = ec_elasticsearch_project.search.id
+  project_type = "elasticsearch"
+  types        = ["observability"]
+  region       = "aws-us-east-1"
+}
+
+output "linkable_project_ids" {
+  value = [for candidate in data.ec_project_link_candidates.observability.candidates : candidate.id]
+}
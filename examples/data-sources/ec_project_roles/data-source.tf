@@ -0,0 +1,13 @@
+resource "ec_security_project" "security" {
+  name      = "security"
+  region_id = "aws-us-east-1"
+}
+
+data "ec_project_roles" "security" {
+  project_id   = ec_security_project.security.id
+  project_type = "security"
+}
+
+output "custom_role_names" {
+  value = [for role in data.ec_project_roles.security.roles : role.name if !role.built_in]
+}
@@ -0,0 +1,13 @@
+data "ec_serverless_traffic_filter" "office" {
+  name   = "office"
+  region = "aws-us-east-1"
+}
+
+resource "ec_elasticsearch_project" "project" {
+  name      = "project"
+  region_id = "aws-us-east-1"
+
+  traffic_filter_ids = [
+    for filter in data.ec_serverless_traffic_filter.office.traffic_filters : filter.id
+  ]
+}
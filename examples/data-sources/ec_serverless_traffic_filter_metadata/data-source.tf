@@ -0,0 +1,21 @@
+data "ec_serverless_traffic_filter_metadata" "us_east_1" {
+  region = "aws-us-east-1"
+}
+
+locals {
+  private_connectivity = data.ec_serverless_traffic_filter_metadata.us_east_1.regions[0]
+}
+
+resource "aws_vpc_endpoint" "elastic" {
+  vpc_id            = var.vpc_id
+  service_name      = local.private_connectivity.private_service_name
+  vpc_endpoint_type = "Interface"
+}
+
+resource "aws_route53_zone" "elastic" {
+  name = local.private_connectivity.private_hosted_zone_domain_name
+
+  vpc {
+    vpc_id = var.vpc_id
+  }
+}
@@ -0,0 +1,7 @@
+data "ec_snapshot_repositories" "s3" {
+  type = "s3"
+}
+
+output "s3_repository_names" {
+  value = data.ec_snapshot_repositories.s3.names
+}
@@ -0,0 +1,7 @@
+data "ec_snapshot_repository" "found" {
+  name = "found"
+}
+
+output "found_repository_type" {
+  value = data.ec_snapshot_repository.found.type
+}
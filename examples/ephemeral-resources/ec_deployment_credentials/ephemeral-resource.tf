@@ -0,0 +1,11 @@
+ephemeral "ec_deployment_credentials" "example" {
+  deployment_id = ec_deployment.example.id
+}
+
+provider "elasticstack" {
+  elasticsearch {
+    endpoints = [ec_deployment.example.elasticsearch.https_endpoint]
+    username  = ephemeral.ec_deployment_credentials.example.username
+    password  = ephemeral.ec_deployment_credentials.example.password
+  }
+}
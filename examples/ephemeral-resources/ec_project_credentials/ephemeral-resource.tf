@@ -0,0 +1,12 @@
+ephemeral "ec_project_credentials" "example" {
+  project_id   = ec_elasticsearch_project.example.id
+  project_type = "elasticsearch"
+}
+
+provider "elasticstack" {
+  elasticsearch {
+    endpoints = [ec_elasticsearch_project.example.endpoints.elasticsearch]
+    username  = ephemeral.ec_project_credentials.example.username
+    password  = ephemeral.ec_project_credentials.example.password
+  }
+}
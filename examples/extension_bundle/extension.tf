@@ -11,10 +11,6 @@ terraform {
 
 provider "ec" {}
 
-locals {
-  file_path = "./files/content.json.zip"
-}
-
 # Create an Elastic Cloud Extension
 resource "ec_deployment_extension" "example_extension" {
   name           = "my_extension"
@@ -22,6 +18,7 @@ resource "ec_deployment_extension" "example_extension" {
   version        = "*"
   extension_type = "bundle"
 
-  file_path = local.file_path
-  file_hash = filebase64sha256(local.file_path)
+  # The directory is archived by the provider, and uploaded again whenever
+  # its contents change.
+  source_dir = "./files/bundle"
 }
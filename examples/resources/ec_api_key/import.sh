@@ -0,0 +1 @@
+terraform import ec_api_key.ci 3d0a0f4e4c6c4b0f8c2a5e6b7d8e9f01
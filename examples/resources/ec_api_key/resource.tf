@@ -0,0 +1,34 @@
+# Rotate the API key every 30 days
+resource "time_rotating" "ci" {
+  rotation_days = 30
+}
+
+resource "ec_api_key" "ci" {
+  description      = "CI pipelines"
+  expiration       = "45d"
+  rotation_trigger = time_rotating.ci.id
+
+  deployment_roles = [
+    {
+      role            = "editor"
+      all_deployments = true
+    }
+  ]
+
+  project_elasticsearch_roles = [
+    {
+      role         = "admin"
+      all_projects = true
+    }
+  ]
+
+  # Create the new API key before the previous one is deleted
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+
+output "ci_api_key" {
+  value     = ec_api_key.ci.key
+  sensitive = true
+}
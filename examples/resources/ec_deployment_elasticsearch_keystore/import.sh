@@ -0,0 +1 @@
+terraform import ec_deployment_elasticsearch_keystore.gcs_credential 320b7b540dfc967a7a649c18e2fce4ed/gcs.client.default.credentials_file
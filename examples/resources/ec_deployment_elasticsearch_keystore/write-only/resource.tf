@@ -0,0 +1,31 @@
+data "ec_stack" "latest" {
+  version_regex = "latest"
+  region        = "us-east-1"
+}
+
+# Create an Elastic Cloud deployment
+resource "ec_deployment" "example_keystore" {
+  region                 = "us-east-1"
+  version                = data.ec_stack.latest.version
+  deployment_template_id = "aws-io-optimized-v2"
+
+  elasticsearch = {
+    hot = {
+      autoscaling = {}
+    }
+  }
+}
+
+# Read the S3 secret key from a secret manager without persisting it
+ephemeral "aws_secretsmanager_secret_version" "s3_secret_key" {
+  secret_id = "elastic-snapshots-s3-secret-key"
+}
+
+# Create the keystore secret entry. The value is never stored in the state,
+# bump value_wo_version to push a new value to the keystore.
+resource "ec_deployment_elasticsearch_keystore" "s3_secret_key" {
+  deployment_id    = ec_deployment.example_keystore.id
+  setting_name     = "s3.client.default.secret_key"
+  value_wo         = ephemeral.aws_secretsmanager_secret_version.s3_secret_key.secret_string
+  value_wo_version = 1
+}
@@ -0,0 +1,37 @@
+data "ec_stack" "latest" {
+  version_regex = "latest"
+  region        = "us-east-1"
+}
+
+# Create an Elastic Cloud deployment
+resource "ec_deployment" "example_keystore" {
+  region                 = "us-east-1"
+  version                = data.ec_stack.latest.version
+  deployment_template_id = "aws-io-optimized-v2"
+
+  elasticsearch = {
+    hot = {
+      autoscaling = {}
+    }
+  }
+}
+
+# Write all the keystore settings in a single change, removing any setting
+# which isn't listed here.
+resource "ec_deployment_elasticsearch_keystore_settings" "example" {
+  deployment_id = ec_deployment.example_keystore.id
+  exclusive     = true
+
+  settings = {
+    "s3.client.default.access_key" = {
+      value = var.s3_access_key
+    }
+    "s3.client.default.secret_key" = {
+      value = var.s3_secret_key
+    }
+    "gcs.client.default.credentials_file" = {
+      value   = file("service-account-key.json")
+      as_file = true
+    }
+  }
+}
@@ -1,15 +1,14 @@
-locals {
-  file_path = "/path/to/plugin.zip"
-}
-
 resource "ec_deployment_extension" "example_extension" {
   name           = "my_extension"
   description    = "my extension"
   version        = "*"
   extension_type = "bundle"
 
-  file_path = local.file_path
-  file_hash = filebase64sha256(local.file_path)
+  # The file is uploaded again whenever its SHA-256 changes.
+  file_path = "/path/to/plugin.zip"
+
+  # Allow more time for large extensions or slow networks.
+  upload_timeout = "30m"
 }
 
 data "ec_stack" "latest" {
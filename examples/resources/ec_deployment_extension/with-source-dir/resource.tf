@@ -0,0 +1,10 @@
+# The bundle directory holds the files in the layout Elasticsearch expects,
+# e.g. dictionaries/synonyms.txt or scripts/my-script.painless.
+resource "ec_deployment_extension" "example_extension" {
+  name           = "my_extension"
+  description    = "my extension"
+  version        = "*"
+  extension_type = "bundle"
+
+  source_dir = "/path/to/bundle"
+}
@@ -0,0 +1 @@
+terraform import ec_deployment_traffic_filter_associations.name 320b7b540dfc967a7a649c18e2fce4ed
@@ -0,0 +1,19 @@
+data "ec_deployments" "production" {
+  name_prefix = "production"
+}
+
+resource "ec_deployment_traffic_filter" "vpce" {
+  name   = "shared VPC endpoint"
+  region = "us-east-1"
+  type   = "vpce"
+
+  rule {
+    source = "vpce-01234567890abcdef"
+  }
+}
+
+# Associate the ruleset to all the production deployments
+resource "ec_deployment_traffic_filter_associations" "production" {
+  traffic_filter_id = ec_deployment_traffic_filter.vpce.id
+  deployment_ids    = toset(data.ec_deployments.production.deployments[*].deployment_id)
+}
@@ -0,0 +1,33 @@
+data "ec_stack" "latest" {
+  version_regex = "latest"
+  region        = "us-east-1"
+}
+
+variable "office_networks" {
+  description = "CIDR blocks of the office networks"
+  type        = set(string)
+}
+
+# Spread the office networks across as many traffic filter rulesets as needed
+resource "ec_deployment_traffic_filter_group" "offices" {
+  name    = "office-networks"
+  region  = "us-east-1"
+  sources = var.office_networks
+}
+
+resource "ec_deployment" "example_minimal" {
+  name                   = "my_example_deployment"
+  region                 = "us-east-1"
+  version                = data.ec_stack.latest.version
+  deployment_template_id = "aws-io-optimized-v2"
+
+  traffic_filter = ec_deployment_traffic_filter_group.offices.ruleset_ids
+
+  elasticsearch = {
+    hot = {
+      autoscaling = {}
+    }
+  }
+
+  kibana = {}
+}
@@ -0,0 +1 @@
+terraform import ec_organization_member.example user@example.com
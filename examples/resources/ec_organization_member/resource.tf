@@ -0,0 +1,24 @@
+resource "ec_organization_member" "example" {
+  email             = "user@example.com"
+  organization_role = "billing-admin"
+
+  # Send invitations valid for two weeks, and fail the plan when the
+  # invitation is still not accepted after a month.
+  invitation_expires_in       = "14d"
+  pending_invitation_max_days = 30
+  pending_invitation_action   = "fail"
+
+  deployment_roles = [
+    {
+      role            = "viewer"
+      all_deployments = true
+    }
+  ]
+
+  project_elasticsearch_roles = [
+    {
+      role         = "admin"
+      all_projects = true
+    }
+  ]
+}
@@ -1,11 +1,10 @@
 resource "ec_snapshot_repository" "this" {
   name = "my-snapshot-repository"
-  generic = {
-    type = "azure"
-    settings = jsonencode({
-      container = "my_container"
-      client    = "my_alternate_client"
-      compress  = false
-    })
+  azure = {
+    container     = "my-container"
+    client        = "my_alternate_client"
+    base_path     = "snapshots"
+    compress      = false
+    location_mode = "primary_only"
   }
 }
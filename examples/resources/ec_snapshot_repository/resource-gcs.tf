@@ -1,11 +1,10 @@
 resource "ec_snapshot_repository" "this" {
   name = "my-snapshot-repository"
-  generic = {
-    type = "gcs"
-    settings = jsonencode({
-      bucket   = "my_bucket"
-      client   = "my_alternate_client"
-      compress = false
-    })
+  gcs = {
+    bucket     = "my-bucket"
+    client     = "my_alternate_client"
+    base_path  = "snapshots"
+    compress   = false
+    chunk_size = "100mb"
   }
 }
@@ -0,0 +1,11 @@
+resource "ec_snapshot_repository" "this" {
+  name = "my-snapshot-repository"
+  generic = {
+    type = "gcs"
+    settings = jsonencode({
+      bucket                    = "my-bucket"
+      client                    = "my_alternate_client"
+      max_restore_bytes_per_sec = "40mb"
+    })
+  }
+}